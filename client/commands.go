@@ -0,0 +1,724 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// call makes the RPC of a command with its positional arguments. It returns
+// the response to print, or nil if it printed what it got itself.
+type call func(ctx context.Context, c *cli, args []string) (proto.Message, error)
+
+// command is a subcommand of the client.
+type command struct {
+	name    string
+	args    string
+	summary string
+	// Only admins may run it
+	admin bool
+	// It streams until interrupted, so the timeout does not apply
+	stream bool
+	// setup registers the command's flags and returns the call to make once
+	// they are parsed
+	setup func(fs *flag.FlagSet) call
+}
+
+// Helper function to make the flag set of a command
+func (cmd *command) flagSet(output io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet("client "+cmd.name, flag.ContinueOnError)
+	fs.SetOutput(output)
+	fs.Usage = func() {
+		fmt.Fprintf(output, "Usage: client [flags] %s\n\n%s\n", strings.TrimSpace(cmd.name+" [flags] "+cmd.args), cmd.summary)
+		// List the command's own flags, not the shared ones
+		own := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+		own.SetOutput(output)
+		cmd.setup(own)
+		hasFlags := false
+		own.VisitAll(func(*flag.Flag) { hasFlags = true })
+		if hasFlags {
+			fmt.Fprintf(output, "\nFlags:\n")
+			own.PrintDefaults()
+		}
+	}
+	return fs
+}
+
+// Helper function to find a command by name
+func findCommand(name string) *command {
+	for i := range commands {
+		if commands[i].name == name {
+			return &commands[i]
+		}
+	}
+	return nil
+}
+
+// Helper function to check a command got exactly the arguments it takes
+func wantArgs(args []string, names ...string) error {
+	switch {
+	case len(args) == len(names):
+		return nil
+	case len(names) == 0:
+		return usageError("takes no arguments")
+	default:
+		return usageError(fmt.Sprintf("expected %d argument(s): %s", len(names), strings.Join(names, " ")))
+	}
+}
+
+// Helper function to read an optional purchase id argument
+func optionalPurchaseID(args []string) (string, error) {
+	switch len(args) {
+	case 0:
+		return "", nil
+	case 1:
+		return args[0], nil
+	default:
+		return "", usageError("expected at most one argument: PURCHASE_ID")
+	}
+}
+
+// Helper function to send an idempotency key with a call, if one was given
+func withIdempotencyKey(ctx context.Context, key string) context.Context {
+	if key == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, "idempotency-key", key)
+}
+
+// passengerList is a repeatable flag of passengers written as
+// "First Last" or "First Last <email>".
+type passengerList []*pb.User
+
+func (p *passengerList) String() string {
+	return ""
+}
+
+func (p *passengerList) Set(value string) error {
+	user := &pb.User{}
+	value = strings.TrimSpace(value)
+	if open := strings.Index(value, "<"); open >= 0 && strings.HasSuffix(value, ">") {
+		user.Email = strings.TrimSpace(value[open+1 : len(value)-1])
+		value = strings.TrimSpace(value[:open])
+	}
+	first, last, ok := strings.Cut(value, " ")
+	if !ok {
+		return errors.New(`expected "First Last" or "First Last <email>"`)
+	}
+	user.FirstName, user.LastName = first, strings.TrimSpace(last)
+	*p = append(*p, user)
+	return nil
+}
+
+// seatList is a flag of seat numbers and ranges, such as "1,2,7-10".
+type seatList []int32
+
+func (s *seatList) String() string {
+	return ""
+}
+
+func (s *seatList) Set(value string) error {
+	for _, part := range strings.Split(value, ",") {
+		from, to, isRange := strings.Cut(strings.TrimSpace(part), "-")
+		first, err := strconv.ParseInt(from, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid seat number %q", part)
+		}
+		last := first
+		if isRange {
+			if last, err = strconv.ParseInt(to, 10, 32); err != nil || last < first {
+				return fmt.Errorf("invalid seat range %q", part)
+			}
+		}
+		for n := first; n <= last; n++ {
+			*s = append(*s, int32(n))
+		}
+	}
+	return nil
+}
+
+// Helper function to read a seat position preference
+func seatPosition(value string) (pb.SeatPosition, error) {
+	switch value {
+	case "", "any":
+		return pb.SeatPosition_SEAT_POSITION_ANY, nil
+	case "window":
+		return pb.SeatPosition_SEAT_POSITION_WINDOW, nil
+	case "aisle":
+		return pb.SeatPosition_SEAT_POSITION_AISLE, nil
+	default:
+		return 0, usageError(fmt.Sprintf("invalid -position %q (expected window, aisle or any)", value))
+	}
+}
+
+// Every command, in the order the usage lists them
+var commands = []command{
+	{
+		name:    "login",
+		args:    "",
+		summary: "Sign in with an email, one of its bookings and that booking's secret, or with -admin-secret, and print the access token",
+		setup: func(fs *flag.FlagSet) call {
+			email := fs.String("email", "", "email of the customer who booked")
+			purchaseID := fs.String("purchase-id", "", "a purchase booked by the email")
+			bookingSecret := fs.String("booking-secret", "", "the booking secret printed by purchase")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args); err != nil {
+					return nil, err
+				}
+				loginRequest := &pb.LoginRequest{Email: *email, PurchaseId: *purchaseID, BookingSecret: *bookingSecret}
+				if *email == "" && *purchaseID == "" {
+					loginRequest.AdminSecret = c.adminSecret
+				}
+				return c.tickets.Login(ctx, loginRequest)
+			}
+		},
+	},
+	{
+		name:    "purchase",
+		args:    "",
+		summary: "Buy tickets for a passenger or a group",
+		setup: func(fs *flag.FlagSet) call {
+			purchaseRequest := &pb.PurchaseRequest{User: &pb.User{}}
+			fs.StringVar(&purchaseRequest.From, "from", "", "station to board at")
+			fs.StringVar(&purchaseRequest.To, "to", "", "station to leave at")
+			fs.StringVar(&purchaseRequest.User.FirstName, "first-name", "", "first name of the customer")
+			fs.StringVar(&purchaseRequest.User.LastName, "last-name", "", "last name of the customer")
+			fs.StringVar(&purchaseRequest.User.Email, "email", "", "email of the customer")
+			fs.StringVar(&purchaseRequest.TrainId, "train", "", "train id (optional)")
+			fs.StringVar(&purchaseRequest.Date, "date", "", "departure date, YYYY-MM-DD (optional)")
+			fs.StringVar(&purchaseRequest.SeatClass, "class", "", "seat class (optional)")
+			fs.StringVar(&purchaseRequest.PromoCode, "promo", "", "promo code (optional)")
+			fs.StringVar(&purchaseRequest.PaymentToken, "payment-token", "", "payment token from the payment provider")
+			passengers := &passengerList{}
+			fs.Var(passengers, "passenger", `a passenger of a group booking, "First Last" or "First Last <email>"; repeat for each`)
+			idempotencyKey := fs.String("idempotency-key", "", "make retrying this purchase safe")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args); err != nil {
+					return nil, err
+				}
+				purchaseRequest.Passengers = *passengers
+				return c.tickets.PurchaseTicket(withIdempotencyKey(ctx, *idempotencyKey), purchaseRequest)
+			}
+		},
+	},
+	{
+		name:    "allocate",
+		args:    "[PURCHASE_ID]",
+		summary: "Allocate a seat to a ticket, or to every passenger of a booking",
+		setup: func(fs *flag.FlagSet) call {
+			allocateSeatRequest := &pb.AllocateSeatRequest{}
+			fs.StringVar(&allocateSeatRequest.Section, "section", "", "section to sit in; may be empty with preferences")
+			fs.StringVar(&allocateSeatRequest.Email, "email", "", "find the ticket by email instead of purchase id")
+			fs.StringVar(&allocateSeatRequest.BookingId, "booking", "", "seat every unseated passenger of this booking")
+			fs.BoolVar(&allocateSeatRequest.Contiguous, "contiguous", false, "with -booking, seat the passengers together")
+			position := fs.String("position", "", "preferred seat position: window, aisle or any")
+			preferences := &pb.SeatPreferences{}
+			fs.BoolVar(&preferences.ForwardFacing, "forward-facing", false, "prefer a forward-facing seat")
+			fs.BoolVar(&preferences.NearExit, "near-exit", false, "prefer a seat near an exit")
+			fs.BoolVar(&preferences.QuietCoach, "quiet", false, "prefer a quiet coach")
+			fs.StringVar(&preferences.NextToPurchaseId, "next-to", "", "prefer a seat beside this purchase")
+			idempotencyKey := fs.String("idempotency-key", "", "make retrying this allocation safe")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				purchaseID, err := optionalPurchaseID(args)
+				if err != nil {
+					return nil, err
+				}
+				if preferences.Position, err = seatPosition(*position); err != nil {
+					return nil, err
+				}
+				allocateSeatRequest.PurchaseId = purchaseID
+				if !proto.Equal(preferences, &pb.SeatPreferences{}) {
+					allocateSeatRequest.Preferences = preferences
+				}
+				return c.tickets.AllocateSeat(withIdempotencyKey(ctx, *idempotencyKey), allocateSeatRequest)
+			}
+		},
+	},
+	{
+		name:    "receipt",
+		args:    "[PURCHASE_ID]",
+		summary: "Show a ticket",
+		setup: func(fs *flag.FlagSet) call {
+			email := fs.String("email", "", "find the ticket by email instead of purchase id")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				purchaseID, err := optionalPurchaseID(args)
+				if err != nil {
+					return nil, err
+				}
+				return c.tickets.ShowReceipt(ctx, &pb.ShowReceiptRequest{PurchaseId: purchaseID, Email: *email})
+			}
+		},
+	},
+	{
+		name:    "modify",
+		args:    "[PURCHASE_ID]",
+		summary: "Move a ticket to another seat",
+		setup: func(fs *flag.FlagSet) call {
+			modifySeatRequest := &pb.ModifySeatRequest{}
+			fs.StringVar(&modifySeatRequest.NewSection, "section", "", "section of the new seat")
+			fs.Var((*int32Value)(&modifySeatRequest.NewSeatNumber), "seat", "number of the new seat")
+			fs.StringVar(&modifySeatRequest.Email, "email", "", "find the ticket by email instead of purchase id")
+			idempotencyKey := fs.String("idempotency-key", "", "make retrying this change safe")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				purchaseID, err := optionalPurchaseID(args)
+				if err != nil {
+					return nil, err
+				}
+				modifySeatRequest.PurchaseId = purchaseID
+				return c.tickets.ModifySeat(withIdempotencyKey(ctx, *idempotencyKey), modifySeatRequest)
+			}
+		},
+	},
+	{
+		name:    "bookings",
+		args:    "",
+		summary: "List the tickets held or booked by an email",
+		setup: func(fs *flag.FlagSet) call {
+			email := fs.String("email", "", "email to list; defaults to the signed-in passenger")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args); err != nil {
+					return nil, err
+				}
+				return c.tickets.ListBookings(ctx, &pb.ListBookingsRequest{Email: *email})
+			}
+		},
+	},
+	{
+		name:    "cancel",
+		args:    "PURCHASE_ID",
+		summary: "Cancel a ticket and refund it, less the cancellation fee",
+		setup: func(fs *flag.FlagSet) call {
+			reason := fs.String("reason", "", "why the ticket is cancelled")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args, "PURCHASE_ID"); err != nil {
+					return nil, err
+				}
+				return c.tickets.CancelTicket(ctx, &pb.CancelTicketRequest{PurchaseId: args[0], Reason: *reason})
+			}
+		},
+	},
+	{
+		name:    "hold",
+		args:    "PURCHASE_ID",
+		summary: "Hold a seat for a ticket while the passenger decides",
+		setup: func(fs *flag.FlagSet) call {
+			holdSeatRequest := &pb.HoldSeatRequest{}
+			fs.StringVar(&holdSeatRequest.Section, "section", "", "section of the seat")
+			fs.Var((*int32Value)(&holdSeatRequest.SeatNumber), "seat", "number of the seat; 0 for the first free one")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args, "PURCHASE_ID"); err != nil {
+					return nil, err
+				}
+				holdSeatRequest.PurchaseId = args[0]
+				return c.tickets.HoldSeat(ctx, holdSeatRequest)
+			}
+		},
+	},
+	{
+		name:    "confirm-hold",
+		args:    "HOLD_ID",
+		summary: "Take a held seat",
+		setup: func(fs *flag.FlagSet) call {
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args, "HOLD_ID"); err != nil {
+					return nil, err
+				}
+				return c.tickets.ConfirmHold(ctx, &pb.ConfirmHoldRequest{HoldId: args[0]})
+			}
+		},
+	},
+	{
+		name:    "waitlist-join",
+		args:    "PURCHASE_ID",
+		summary: "Wait for a seat on a full train",
+		setup: func(fs *flag.FlagSet) call {
+			section := fs.String("section", "", "wait for a seat in this section only")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args, "PURCHASE_ID"); err != nil {
+					return nil, err
+				}
+				return c.tickets.JoinWaitlist(ctx, &pb.JoinWaitlistRequest{PurchaseId: args[0], Section: *section})
+			}
+		},
+	},
+	{
+		name:    "waitlist-leave",
+		args:    "PURCHASE_ID",
+		summary: "Stop waiting for a seat",
+		setup: func(fs *flag.FlagSet) call {
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args, "PURCHASE_ID"); err != nil {
+					return nil, err
+				}
+				return c.tickets.LeaveWaitlist(ctx, &pb.LeaveWaitlistRequest{PurchaseId: args[0]})
+			}
+		},
+	},
+	{
+		name:    "waitlist-position",
+		args:    "PURCHASE_ID",
+		summary: "Show a ticket's place on the waitlist",
+		setup: func(fs *flag.FlagSet) call {
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args, "PURCHASE_ID"); err != nil {
+					return nil, err
+				}
+				return c.tickets.GetWaitlistPosition(ctx, &pb.GetWaitlistPositionRequest{PurchaseId: args[0]})
+			}
+		},
+	},
+	{
+		name:    "check-in",
+		args:    "PURCHASE_ID",
+		summary: "Check in for a train",
+		setup: func(fs *flag.FlagSet) call {
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args, "PURCHASE_ID"); err != nil {
+					return nil, err
+				}
+				return c.tickets.CheckIn(ctx, &pb.CheckInRequest{PurchaseId: args[0]})
+			}
+		},
+	},
+	{
+		name:    "board",
+		args:    "PURCHASE_ID",
+		summary: "Board a train after checking in",
+		setup: func(fs *flag.FlagSet) call {
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args, "PURCHASE_ID"); err != nil {
+					return nil, err
+				}
+				return c.tickets.Board(ctx, &pb.BoardRequest{PurchaseId: args[0]})
+			}
+		},
+	},
+	{
+		name:    "swap",
+		args:    "PURCHASE_ID OTHER_PURCHASE_ID",
+		summary: "Ask to swap seats with another passenger, or swap them as an admin",
+		setup: func(fs *flag.FlagSet) call {
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args, "PURCHASE_ID", "OTHER_PURCHASE_ID"); err != nil {
+					return nil, err
+				}
+				return c.tickets.SwapSeats(ctx, &pb.SwapSeatsRequest{PurchaseIdA: args[0], PurchaseIdB: args[1]})
+			}
+		},
+	},
+	{
+		name:    "trains",
+		args:    "",
+		summary: "List the trains and their timetables",
+		setup: func(fs *flag.FlagSet) call {
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args); err != nil {
+					return nil, err
+				}
+				return c.tickets.ListTrains(ctx, &pb.ListTrainsRequest{})
+			}
+		},
+	},
+	{
+		name:    "search",
+		args:    "",
+		summary: "Find the trains between two stations",
+		setup: func(fs *flag.FlagSet) call {
+			searchJourneysRequest := &pb.SearchJourneysRequest{}
+			fs.StringVar(&searchJourneysRequest.From, "from", "", "station to board at")
+			fs.StringVar(&searchJourneysRequest.To, "to", "", "station to leave at")
+			fs.StringVar(&searchJourneysRequest.Date, "date", "", "travel date, YYYY-MM-DD (optional)")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args); err != nil {
+					return nil, err
+				}
+				return c.tickets.SearchJourneys(ctx, searchJourneysRequest)
+			}
+		},
+	},
+	{
+		name:    "quote",
+		args:    "",
+		summary: "Show what a ticket would cost",
+		setup: func(fs *flag.FlagSet) call {
+			quoteFareRequest := &pb.QuoteFareRequest{}
+			fs.StringVar(&quoteFareRequest.From, "from", "", "station to board at")
+			fs.StringVar(&quoteFareRequest.To, "to", "", "station to leave at")
+			fs.StringVar(&quoteFareRequest.TrainId, "train", "", "train id (optional)")
+			fs.StringVar(&quoteFareRequest.Date, "date", "", "departure date, YYYY-MM-DD (optional)")
+			fs.StringVar(&quoteFareRequest.SeatClass, "class", "", "seat class (optional)")
+			fs.StringVar(&quoteFareRequest.PromoCode, "promo", "", "promo code (optional)")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args); err != nil {
+					return nil, err
+				}
+				return c.tickets.QuoteFare(ctx, quoteFareRequest)
+			}
+		},
+	},
+	{
+		name:    "seat-map",
+		args:    "",
+		summary: "Show which seats of a departure are free",
+		setup: func(fs *flag.FlagSet) call {
+			getSeatMapRequest := &pb.GetSeatMapRequest{}
+			fs.StringVar(&getSeatMapRequest.TrainId, "train", "", "train id (optional with a single train)")
+			fs.StringVar(&getSeatMapRequest.Date, "date", "", "departure date; the next departure if empty")
+			fs.StringVar(&getSeatMapRequest.Section, "section", "", "show one section only")
+			fs.StringVar(&getSeatMapRequest.From, "from", "", "show occupancy from this station")
+			fs.StringVar(&getSeatMapRequest.To, "to", "", "show occupancy to this station")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args); err != nil {
+					return nil, err
+				}
+				return c.tickets.GetSeatMap(ctx, getSeatMapRequest)
+			}
+		},
+	},
+	{
+		name:    "watch",
+		args:    "",
+		summary: "Print seat availability changes as they happen, until interrupted",
+		stream:  true,
+		setup: func(fs *flag.FlagSet) call {
+			watchRequest := &pb.WatchSeatAvailabilityRequest{}
+			fs.StringVar(&watchRequest.TrainId, "train", "", "train id (optional with a single train)")
+			fs.StringVar(&watchRequest.Date, "date", "", "departure date; the next departure if empty")
+			fs.StringVar(&watchRequest.Section, "section", "", "watch one section only")
+			fs.StringVar(&watchRequest.From, "from", "", "report occupancy from this station")
+			fs.StringVar(&watchRequest.To, "to", "", "report occupancy to this station")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args); err != nil {
+					return nil, err
+				}
+				stream, err := c.tickets.WatchSeatAvailability(ctx, watchRequest)
+				if err != nil {
+					return nil, err
+				}
+				for {
+					update, err := stream.Recv()
+					if err == io.EOF || ctx.Err() != nil {
+						return nil, nil
+					}
+					if err != nil {
+						return nil, err
+					}
+					if err := c.printer.printUpdate(update); err != nil {
+						return nil, err
+					}
+				}
+			}
+		},
+	},
+	{
+		name:    "list-section",
+		args:    "",
+		summary: "List the passengers seated in a section",
+		admin:   true,
+		setup: func(fs *flag.FlagSet) call {
+			getUsersBySectionRequest := &pb.GetUsersBySectionRequest{}
+			fs.StringVar(&getUsersBySectionRequest.Section, "section", "", "section to list")
+			fs.StringVar(&getUsersBySectionRequest.TrainId, "train", "", "train id (optional)")
+			fs.StringVar(&getUsersBySectionRequest.Date, "date", "", "departure date (optional)")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args); err != nil {
+					return nil, err
+				}
+				return c.tickets.GetUsersBySection(ctx, getUsersBySectionRequest)
+			}
+		},
+	},
+	{
+		name:    "remove",
+		args:    "[PURCHASE_ID]",
+		summary: "Cancel a ticket on the passenger's behalf",
+		admin:   true,
+		setup: func(fs *flag.FlagSet) call {
+			email := fs.String("email", "", "find the ticket by email instead of purchase id")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				purchaseID, err := optionalPurchaseID(args)
+				if err != nil {
+					return nil, err
+				}
+				return c.tickets.RemoveUser(ctx, &pb.RemoveUserRequest{PurchaseId: purchaseID, Email: *email})
+			}
+		},
+	},
+	{
+		name:    "remove-passenger",
+		args:    "PURCHASE_ID",
+		summary: "Force a passenger off a train, even after check-in or departure",
+		admin:   true,
+		setup: func(fs *flag.FlagSet) call {
+			removePassengerRequest := &pb.RemovePassengerRequest{}
+			fs.StringVar(&removePassengerRequest.Reason, "reason", "", "why the passenger is removed")
+			fs.BoolVar(&removePassengerRequest.WaiveFee, "waive-fee", false, "refund the full price")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args, "PURCHASE_ID"); err != nil {
+					return nil, err
+				}
+				removePassengerRequest.PurchaseId = args[0]
+				return c.admin.RemovePassenger(ctx, removePassengerRequest)
+			}
+		},
+	},
+	{
+		name:    "block",
+		args:    "",
+		summary: "Take seats out of service",
+		admin:   true,
+		setup: func(fs *flag.FlagSet) call {
+			blockSeatsRequest := &pb.BlockSeatsRequest{}
+			fs.StringVar(&blockSeatsRequest.TrainId, "train", "", "train id")
+			fs.StringVar(&blockSeatsRequest.Date, "date", "", "block on this date only; every date if empty")
+			fs.StringVar(&blockSeatsRequest.Section, "section", "", "section of the seats")
+			seats := &seatList{}
+			fs.Var(seats, "seats", `seat numbers and ranges, e.g. "1,2,7-10"`)
+			fs.StringVar(&blockSeatsRequest.Reason, "reason", "", "why the seats are blocked")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args); err != nil {
+					return nil, err
+				}
+				blockSeatsRequest.SeatNumbers = *seats
+				return c.admin.BlockSeats(ctx, blockSeatsRequest)
+			}
+		},
+	},
+	{
+		name:    "unblock",
+		args:    "",
+		summary: "Put blocked seats back in service",
+		admin:   true,
+		setup: func(fs *flag.FlagSet) call {
+			unblockSeatsRequest := &pb.UnblockSeatsRequest{}
+			fs.StringVar(&unblockSeatsRequest.TrainId, "train", "", "train id")
+			fs.StringVar(&unblockSeatsRequest.Date, "date", "", "the date the seats were blocked for; empty for every date")
+			fs.StringVar(&unblockSeatsRequest.Section, "section", "", "section of the seats")
+			seats := &seatList{}
+			fs.Var(seats, "seats", `seat numbers and ranges, e.g. "1,2,7-10"`)
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args); err != nil {
+					return nil, err
+				}
+				unblockSeatsRequest.SeatNumbers = *seats
+				return c.admin.UnblockSeats(ctx, unblockSeatsRequest)
+			}
+		},
+	},
+	{
+		name:    "blocks",
+		args:    "",
+		summary: "List the blocked seats",
+		admin:   true,
+		setup: func(fs *flag.FlagSet) call {
+			trainID := fs.String("train", "", "list one train only")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args); err != nil {
+					return nil, err
+				}
+				return c.admin.ListSeatBlocks(ctx, &pb.ListSeatBlocksRequest{TrainId: *trainID})
+			}
+		},
+	},
+	{
+		name:    "move-section",
+		args:    "",
+		summary: "Move every passenger in one section of a departure to another",
+		admin:   true,
+		setup: func(fs *flag.FlagSet) call {
+			moveSectionRequest := &pb.MoveSectionRequest{}
+			fs.StringVar(&moveSectionRequest.TrainId, "train", "", "train id (optional with a single train)")
+			fs.StringVar(&moveSectionRequest.Date, "date", "", "departure date; the next departure if empty")
+			fs.StringVar(&moveSectionRequest.FromSection, "from", "", "section to empty")
+			fs.StringVar(&moveSectionRequest.ToSection, "to", "", "section to move the passengers to")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args); err != nil {
+					return nil, err
+				}
+				return c.admin.MoveSection(ctx, moveSectionRequest)
+			}
+		},
+	},
+	{
+		name:    "manifest",
+		args:    "",
+		summary: "List every ticket sold for a departure",
+		admin:   true,
+		setup: func(fs *flag.FlagSet) call {
+			getManifestRequest := &pb.GetManifestRequest{}
+			fs.StringVar(&getManifestRequest.TrainId, "train", "", "train id (optional with a single train)")
+			fs.StringVar(&getManifestRequest.Date, "date", "", "departure date; the next departure if empty")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args); err != nil {
+					return nil, err
+				}
+				return c.admin.GetManifest(ctx, getManifestRequest)
+			}
+		},
+	},
+	{
+		name:    "create-promo",
+		args:    "CODE",
+		summary: "Create a promo code",
+		admin:   true,
+		setup: func(fs *flag.FlagSet) call {
+			promo := &pb.PromoCode{}
+			fs.StringVar(&promo.Description, "description", "", "what the code is for")
+			fs.Var((*int32Value)(&promo.PercentOff), "percent-off", "discount in percent, 1-100")
+			amountOff := fs.Int64("amount-off", 0, "discount in minor units of -currency, e.g. pence")
+			currency := fs.String("currency", "GBP", "currency of -amount-off")
+			fs.StringVar(&promo.ValidFrom, "valid-from", "", "RFC 3339 time the code starts working (optional)")
+			fs.StringVar(&promo.ValidUntil, "valid-until", "", "RFC 3339 time the code stops working (optional)")
+			fs.Var((*int32Value)(&promo.MaxUses), "max-uses", "uses allowed in total; 0 for unlimited")
+			fs.Var((*int32Value)(&promo.MaxUsesPerEmail), "max-uses-per-email", "uses allowed per email; 0 for unlimited")
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args, "CODE"); err != nil {
+					return nil, err
+				}
+				promo.Code = args[0]
+				if *amountOff != 0 {
+					promo.AmountOff = &pb.Money{AmountMinor: *amountOff, Currency: *currency}
+				}
+				return c.admin.CreatePromoCode(ctx, &pb.CreatePromoCodeRequest{Promo: promo})
+			}
+		},
+	},
+	{
+		name:    "disable-promo",
+		args:    "CODE",
+		summary: "Stop a promo code from being used",
+		admin:   true,
+		setup: func(fs *flag.FlagSet) call {
+			return func(ctx context.Context, c *cli, args []string) (proto.Message, error) {
+				if err := wantArgs(args, "CODE"); err != nil {
+					return nil, err
+				}
+				return c.admin.DisablePromoCode(ctx, &pb.DisablePromoCodeRequest{Code: args[0]})
+			}
+		},
+	},
+}
+
+// int32Value is a flag holding an int32, the type of proto integer fields.
+type int32Value int32
+
+func (v *int32Value) String() string {
+	return strconv.Itoa(int(*v))
+}
+
+func (v *int32Value) Set(value string) error {
+	n, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return errors.New("expected a whole number")
+	}
+	*v = int32Value(n)
+	return nil
+}
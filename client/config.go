@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"gopkg.in/yaml.v3"
+)
+
+// What secrets are replaced with when the configuration is printed
+const redacted = "REDACTED"
+
+// load sets the shared flags from, in order of precedence, the flags given
+// on the command line, the TRAIN_CLIENT_* environment variables named after
+// the flags, the config file at path and the defaults. Without a path it reads
+// the file named by TRAIN_CLIENT_CONFIG, or train/client.yaml in the user
+// config directory if there is one.
+func (o *options) load(path string, flags *flag.FlagSet) error {
+	if path == "" {
+		path = os.Getenv("TRAIN_CLIENT_CONFIG")
+	}
+	if path == "" {
+		if dir, err := os.UserConfigDir(); err == nil {
+			if _, err := os.Stat(filepath.Join(dir, "train", "client.yaml")); err == nil {
+				path = filepath.Join(dir, "train", "client.yaml")
+			}
+		}
+	}
+
+	loaded := defaultOptions()
+	if path != "" {
+		if err := loaded.readFile(path); err != nil {
+			return err
+		}
+	}
+
+	settings := flag.NewFlagSet("client", flag.ContinueOnError)
+	loaded.register(settings)
+	var err error
+	settings.VisitAll(func(f *flag.Flag) {
+		// Shorthands such as -o have no variable of their own. A variable
+		// set to nothing still counts for a text setting, but is no
+		// duration or boolean.
+		value, ok := os.LookupEnv(envName(f.Name))
+		if len(f.Name) == 1 || !ok || err != nil {
+			return
+		}
+		if value == "" && !takesText(f) {
+			err = fmt.Errorf("%s is set to nothing; give it a value or unset it", envName(f.Name))
+			return
+		}
+		if setErr := f.Value.Set(value); setErr != nil {
+			err = fmt.Errorf("invalid value %q for %s: %w", value, envName(f.Name), setErr)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	flags.Visit(func(f *flag.Flag) {
+		if setting := settings.Lookup(f.Name); setting != nil {
+			setting.Value.Set(f.Value.String())
+		}
+	})
+
+	*o = *loaded
+	return nil
+}
+
+// Helper function to name the environment variable that sets a flag. The
+// variables have a prefix of their own, so a client and a server started
+// from the same shell do not share settings such as the admin secret.
+func envName(flagName string) string {
+	return "TRAIN_CLIENT_" + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// Helper function to tell whether a flag takes any text, so that setting it
+// to nothing means something
+func takesText(f *flag.Flag) bool {
+	getter, ok := f.Value.(flag.Getter)
+	if !ok {
+		return true
+	}
+	_, ok = getter.Get().(string)
+	return ok
+}
+
+// readFile applies the settings in a YAML config file.
+func (o *options) readFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+
+	// Reject unknown settings, so a misspelt one is not silently ignored
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(o); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("parse config %s: %w", path, err)
+	}
+	return nil
+}
+
+// print writes the shared flags as YAML, in the form the config file takes.
+func (o *options) print(w io.Writer) error {
+	printed := *o
+	if printed.Token != "" {
+		printed.Token = redacted
+	}
+	if printed.AdminSecret != "" {
+		printed.AdminSecret = redacted
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&printed); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// credentials returns what to connect to the server with: plaintext unless
+// TLS is asked for, either outright or by naming the certificates to trust.
+func (t tlsOptions) credentials() (credentials.TransportCredentials, error) {
+	if !t.Enabled && t.CAFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	config := &tls.Config{ServerName: t.ServerName, MinVersion: tls.VersionTLS12}
+	if t.CAFile != "" {
+		data, err := os.ReadFile(t.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read CA file: %w", err)
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no PEM certificates in %s", t.CAFile)
+		}
+	}
+	return credentials.NewTLS(config), nil
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOptionsPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "client.yaml")
+	file := `address: "file:8080"
+token: "file-token"
+output: "yaml"
+admin_secret: "file-secret"
+`
+	if err := os.WriteFile(path, []byte(file), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	// Set to nothing, TRAIN_CLIENT_TOKEN still clears the token from the
+	// file. The server's variables are not the client's.
+	t.Setenv("TRAIN_CLIENT_TOKEN", "")
+	t.Setenv("TRAIN_CLIENT_OUTPUT", "json")
+	t.Setenv("TRAIN_CLIENT_ADDR", "env:8080")
+	t.Setenv("TRAIN_ADMIN_SECRET", "server-secret")
+
+	opts := defaultOptions()
+	flags := flag.NewFlagSet("client", flag.ContinueOnError)
+	opts.register(flags)
+	if err := flags.Parse([]string{"-addr", "flag:8080"}); err != nil {
+		t.Fatalf("parse flags: %v", err)
+	}
+	if err := opts.load(path, flags); err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	if opts.Timeout != defaultTimeout {
+		t.Errorf("timeout = %v, want the default %v", opts.Timeout, defaultTimeout)
+	}
+	if opts.AdminSecret != "file-secret" {
+		t.Errorf("admin secret = %q, want file-secret from the file, not the server's", opts.AdminSecret)
+	}
+	if opts.Token != "" {
+		t.Errorf("token = %q, want it emptied by the environment", opts.Token)
+	}
+	if opts.Output != "json" {
+		t.Errorf("output = %q, want json from the environment", opts.Output)
+	}
+	if opts.Address != "flag:8080" {
+		t.Errorf("address = %q, want flag:8080 from the flag", opts.Address)
+	}
+}
+
+func TestEmptyEnvironmentVariableOnlySetsText(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "client.yaml")
+	if err := os.WriteFile(path, nil, 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	t.Setenv("TRAIN_CLIENT_TLS", "")
+
+	opts := defaultOptions()
+	flags := flag.NewFlagSet("client", flag.ContinueOnError)
+	opts.register(flags)
+	err := opts.load(path, flags)
+	if err == nil || !strings.Contains(err.Error(), "TRAIN_CLIENT_TLS is set to nothing") {
+		t.Fatalf("load with TRAIN_CLIENT_TLS empty: got %v, want it rejected by name", err)
+	}
+}
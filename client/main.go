@@ -2,167 +2,387 @@ package main
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
-	"log"
+	"io"
+	"os"
+	"os/signal"
 	"time"
 
-	"github.com/harshithvh/go_gRPC/proto"
+	pb "github.com/harshithvh/go_gRPC/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
-const serverAddress = "localhost:8080"
+const defaultServerAddress = "localhost:8080"
+
+// How long a call may take unless told otherwise
+const defaultTimeout = 10 * time.Second
+
+// Exit statuses, so scripts can tell failures apart
+const (
+	exitOK          = 0
+	exitError       = 1
+	exitUsage       = 2
+	exitInvalid     = 3
+	exitNotFound    = 4
+	exitConflict    = 5
+	exitDenied      = 6
+	exitUnavailable = 7
+	exitExhausted   = 8
+)
 
-func main() {
-	// Connect to the gRPC server
-	conn, err := grpc.Dial(serverAddress, grpc.WithInsecure())
-	if err != nil {
-		log.Fatalf("Failed to connect to server: %v", err)
-	}
-	defer conn.Close()
+// The exit status for each gRPC status code; codes not listed exit with
+// exitError
+var exitCodes = map[codes.Code]int{
+	codes.OK:                 exitOK,
+	codes.InvalidArgument:    exitInvalid,
+	codes.OutOfRange:         exitInvalid,
+	codes.NotFound:           exitNotFound,
+	codes.AlreadyExists:      exitConflict,
+	codes.FailedPrecondition: exitConflict,
+	codes.Aborted:            exitConflict,
+	codes.Unauthenticated:    exitDenied,
+	codes.PermissionDenied:   exitDenied,
+	codes.Unavailable:        exitUnavailable,
+	codes.DeadlineExceeded:   exitUnavailable,
+	codes.ResourceExhausted:  exitExhausted,
+}
 
-	// Create a gRPC client
-	client := proto.NewTicketServiceClient(conn)
+// usageError is a mistake in the command line rather than a failed call.
+type usageError string
 
-	purchaseTicket(client)
-	time.Sleep(3 * time.Second)
-	allocateSeat(client)
-	showReceipt(client)
-	getUsersBySection(client)
-	removeUser(client)
-	modifySeat(client)
+func (e usageError) Error() string {
+	return string(e)
+}
+
+// cli is a connection to the server and the options every command shares.
+type cli struct {
+	address     string
+	tls         tlsOptions
+	tickets     pb.TicketServiceClient
+	admin       pb.TicketAdminServiceClient
+	token       string
+	adminSecret string
+	timeout     time.Duration
+	printer     *printer
+}
 
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
 }
 
-func purchaseTicket(client proto.TicketServiceClient) {
-	// Example of using PurchaseTicket function
-	purchaseRequest := &proto.PurchaseRequest{
-		From: "London",
-		To:   "France",
-		User: &proto.User{
-			FirstName: "John",
-			LastName:  "Doe",
-			Email:     "john.doe198@gmail.com",
-		},
+// options are the flags every command takes, before or after its name.
+// They may also be set in the environment and the config file.
+type options struct {
+	Address     string        `yaml:"address"`
+	Timeout     time.Duration `yaml:"timeout"`
+	Output      string        `yaml:"output"`
+	Token       string        `yaml:"token"`
+	AdminSecret string        `yaml:"admin_secret"`
+	TLS         tlsOptions    `yaml:"tls"`
+}
+
+// tlsOptions are how the client checks the server when connecting over TLS.
+type tlsOptions struct {
+	Enabled    bool   `yaml:"enabled"`
+	CAFile     string `yaml:"ca_file"`
+	ServerName string `yaml:"server_name"`
+}
+
+// defaultOptions are the shared flags before the config file, environment
+// or command line set them.
+func defaultOptions() *options {
+	return &options{
+		Address: defaultServerAddress,
+		Timeout: defaultTimeout,
+		Output:  "table",
 	}
+}
 
-	purchaseResponse, err := client.PurchaseTicket(context.Background(), purchaseRequest)
-	if err != nil {
-		log.Fatalf("Error calling PurchaseTicket: %v", err)
+// Helper function to register the shared flags on a flag set, defaulting to
+// their current values
+func (o *options) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.Address, "addr", o.Address, "server address")
+	fs.DurationVar(&o.Timeout, "timeout", o.Timeout, "deadline for each call; 0 for none")
+	fs.StringVar(&o.Output, "output", o.Output, "output format: table, json or yaml")
+	fs.StringVar(&o.Output, "o", o.Output, "shorthand for -output")
+	fs.StringVar(&o.Token, "token", o.Token, "access token from login or purchase")
+	fs.StringVar(&o.AdminSecret, "admin-secret", o.AdminSecret, "sign in as an admin with this secret")
+	fs.BoolVar(&o.TLS.Enabled, "tls", o.TLS.Enabled, "connect over TLS, trusting the system's certificate authorities")
+	fs.StringVar(&o.TLS.CAFile, "ca-file", o.TLS.CAFile, "connect over TLS, trusting the PEM certificates in this file")
+	fs.StringVar(&o.TLS.ServerName, "server-name", o.TLS.ServerName, "name the server's certificate must have (default the host of -addr)")
+}
+
+// Helper function to check the shared flags and make the printer they ask for
+func (o *options) printer(stdout io.Writer) (*printer, error) {
+	if o.Timeout < 0 {
+		return nil, errors.New("-timeout cannot be negative")
 	}
+	return newPrinter(o.Output, stdout)
+}
 
-	// Convert the PurchaseResponse to indented JSON format
-	jsonResponse, err := json.MarshalIndent(purchaseResponse, "", "  ")
-	if err != nil {
-		log.Fatalf("Error marshaling PurchaseResponse to JSON: %v", err)
+// run carries out one command line and returns the exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	opts := defaultOptions()
+	flags := flag.NewFlagSet("client", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	opts.register(flags)
+	configPath := flags.String("config", "", "YAML config file (env TRAIN_CLIENT_CONFIG; default train/client.yaml in the user config directory, if there is one)")
+	printConfig := flags.Bool("print-config", false, "print the shared flags in effect, with secrets redacted, and exit")
+	flags.Usage = func() {
+		printUsage(stderr, flags)
 	}
 
-	// Print
-	fmt.Printf("PurchaseTicket Response:\n%s\n", jsonResponse)
-}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
 
-func allocateSeat(client proto.TicketServiceClient) {
+	// Flags given take precedence over the environment, and the environment
+	// over the config file
+	if err := opts.load(*configPath, flags); err != nil {
+		fmt.Fprintf(stderr, "client: %v\n", err)
+		return exitUsage
+	}
+	if *printConfig {
+		if err := opts.print(stdout); err != nil {
+			fmt.Fprintf(stderr, "client: %v\n", err)
+			return exitError
+		}
+		return exitOK
+	}
+	if flags.NArg() == 0 {
+		printUsage(stderr, flags)
+		return exitUsage
+	}
 
-	// Example of using AllocateSeat function
-	allocateSeatRequest := &proto.AllocateSeatRequest{
-		Email: "john.doe198@gmail.com",
-		Section: "A",
+	name := flags.Arg(0)
+	if name == "help" {
+		if flags.NArg() > 1 {
+			if flags.Arg(1) == "shell" {
+				printShellHelp(stdout)
+				return exitOK
+			}
+			if cmd := findCommand(flags.Arg(1)); cmd != nil {
+				cmd.flagSet(stdout).Usage()
+				return exitOK
+			}
+		}
+		printUsage(stdout, flags)
+		return exitOK
+	}
+	if name == "shell" {
+		return runShell(opts, flags.Args()[1:], stdin, stdout, stderr)
+	}
+	cmd := findCommand(name)
+	if cmd == nil {
+		fmt.Fprintf(stderr, "client: unknown command %q (run 'client help' for the list)\n", name)
+		return exitUsage
 	}
 
-	allocateSeatResponse, err := client.AllocateSeat(context.Background(), allocateSeatRequest)
+	// Parse the command's own flags, and the shared ones again so they may
+	// follow the command, before connecting
+	fs := cmd.flagSet(stderr)
+	call := cmd.setup(fs)
+	opts.register(fs)
+	positional, err := parseArgs(fs, flags.Args()[1:])
 	if err != nil {
-		log.Fatalf("Error calling AllocateSeat: %v", err)
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
 	}
 
-	// Convert the AllocateSeatResponse to indented JSON format
-	allocateSeatJSON, err := json.MarshalIndent(allocateSeatResponse, "", "  ")
-	if err != nil {
-		log.Fatalf("Error marshaling AllocateSeatResponse to JSON: %v", err)
+	c, conn, code := connect(opts, stdout, stderr)
+	if code != exitOK {
+		return code
 	}
+	defer conn.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
 
-	// Print
-	fmt.Printf("AllocateSeat Response:\n%s\n", allocateSeatJSON)
+	_, code = c.execute(ctx, cmd, call, positional, stderr)
+	return code
 }
 
-func showReceipt(client proto.TicketServiceClient) {
-	// Example of using ShowReceipt function
-	showReceiptRequest := &proto.ShowReceiptRequest{
-		Email: "john.doe198@gmail.com",
+// Helper function to connect to the server with the shared flags. It
+// reports a failure itself and returns the exit status.
+func connect(opts *options, stdout, stderr io.Writer) (*cli, *grpc.ClientConn, int) {
+	printer, err := opts.printer(stdout)
+	if err != nil {
+		fmt.Fprintf(stderr, "client: %v\n", err)
+		return nil, nil, exitUsage
 	}
 
-	showReceiptResponse, err := client.ShowReceipt(context.Background(), showReceiptRequest)
+	creds, err := opts.TLS.credentials()
 	if err != nil {
-		log.Fatalf("Error calling ShowReceipt: %v", err)
+		fmt.Fprintf(stderr, "client: %v\n", err)
+		return nil, nil, exitUsage
 	}
 
-	// Convert the ShowReceiptResponse to indented JSON format
-	showReceiptJSON, err := json.MarshalIndent(showReceiptResponse, "", "  ")
+	// Connect to the gRPC server
+	conn, err := grpc.Dial(opts.Address, grpc.WithTransportCredentials(creds))
 	if err != nil {
-		log.Fatalf("Error marshaling ShowReceiptResponse to JSON: %v", err)
+		fmt.Fprintf(stderr, "client: failed to connect to %s: %v\n", opts.Address, err)
+		return nil, nil, exitUnavailable
 	}
-	// Print
-	fmt.Printf("ShowReceipt Response:\n%s\n", showReceiptJSON)
-}
 
-func getUsersBySection(client proto.TicketServiceClient) {
-
-	// Example of using GetUsersBySection function
-	getUsersBySectionRequest := &proto.GetUsersBySectionRequest{
-		Section: "A",
+	c := &cli{
+		address:     opts.Address,
+		tls:         opts.TLS,
+		tickets:     pb.NewTicketServiceClient(conn),
+		admin:       pb.NewTicketAdminServiceClient(conn),
+		token:       opts.Token,
+		adminSecret: opts.AdminSecret,
+		timeout:     opts.Timeout,
+		printer:     printer,
 	}
+	return c, conn, exitOK
+}
 
-	getUsersBySectionResponse, err := client.GetUsersBySection(context.Background(), getUsersBySectionRequest)
-	if err != nil {
-		log.Fatalf("Error calling GetUsersBySection: %v", err)
+// Helper function to get the shared flags back from a connection
+func (c *cli) options() *options {
+	return &options{
+		Address:     c.address,
+		Timeout:     c.timeout,
+		Output:      c.printer.format,
+		Token:       c.token,
+		AdminSecret: c.adminSecret,
+		TLS:         c.tls,
 	}
+}
 
-	// Convert the GetUsersBySectionResponse to indented JSON format
-	getUsersBySectionJSON, err := json.MarshalIndent(getUsersBySectionResponse, "", "  ")
+// execute makes the call of a command and prints its response. It returns
+// the response, if any, and the exit status.
+func (c *cli) execute(ctx context.Context, cmd *command, call call, args []string, stderr io.Writer) (proto.Message, int) {
+	message, err := c.call(ctx, cmd, call, args)
 	if err != nil {
-		log.Fatalf("Error marshaling GetUsersBySectionResponse to JSON: %v", err)
+		return nil, reportError(stderr, cmd, err)
+	}
+	if message != nil {
+		if err := c.printer.print(message); err != nil {
+			fmt.Fprintf(stderr, "client: %v\n", err)
+			return message, exitError
+		}
 	}
-	fmt.Printf("GetUsersBySection Response:\n%s\n", getUsersBySectionJSON)
+	return message, exitOK
 }
 
-func removeUser(client proto.TicketServiceClient) {
-
-	// Example of using RemoveUser function
-	removeUserRequest := &proto.RemoveUserRequest{
-		Email: "john.doe198@gmail.com",
+// Helper function to parse a command's flags, allowing them before and
+// after its positional arguments
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
 	}
+}
 
-	removeUserResponse, err := client.RemoveUser(context.Background(), removeUserRequest)
-	if err != nil {
-		log.Fatalf("Error calling RemoveUser: %v", err)
+// call signs in if needed and makes the call of a command, within the
+// timeout unless it streams.
+func (c *cli) call(ctx context.Context, cmd *command, call call, args []string) (proto.Message, error) {
+	if c.token == "" && c.adminSecret != "" && cmd.name != "login" {
+		loginCtx, cancel := c.withTimeout(ctx)
+		loginResponse, err := c.tickets.Login(loginCtx, &pb.LoginRequest{AdminSecret: c.adminSecret})
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		c.token = loginResponse.AccessToken
 	}
 
-	// Convert the RemoveUserResponse to indented JSON format
-	removeUserJSON, err := json.MarshalIndent(removeUserResponse, "", "  ")
-	if err != nil {
-		log.Fatalf("Error marshaling RemoveUserResponse to JSON: %v", err)
+	if c.token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+c.token)
+	}
+	if !cmd.stream {
+		var cancel context.CancelFunc
+		ctx, cancel = c.withTimeout(ctx)
+		defer cancel()
 	}
-	fmt.Printf("RemoveUser Response:\n%s\n", removeUserJSON)
+	return call(ctx, c, args)
 }
 
-func modifySeat(client proto.TicketServiceClient) {
-
-	// Example of using ModifySeat function
-	modifySeatRequest := &proto.ModifySeatRequest{
-		Email:            "john.doe@gmail.com",
-		NewSection:       "B",
-		NewSeatNumber:    5,
+// Helper function to apply the timeout, if any, to a call
+func (c *cli) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.timeout == 0 {
+		return context.WithCancel(ctx)
 	}
+	return context.WithTimeout(ctx, c.timeout)
+}
 
-	modifySeatResponse, err := client.ModifySeat(context.Background(), modifySeatRequest)
-	if err != nil {
-		log.Fatalf("Error calling ModifySeat: %v", err)
+// Helper function to report a failed command and pick the exit status
+func reportError(stderr io.Writer, cmd *command, err error) int {
+	var usage usageError
+	if errors.As(err, &usage) {
+		fmt.Fprintf(stderr, "client %s: %v (run 'client help %s' for usage)\n", cmd.name, err, cmd.name)
+		return exitUsage
 	}
 
-	// Convert the ModifySeatResponse to indented JSON format
-	modifySeatJSON, err := json.MarshalIndent(modifySeatResponse, "", "  ")
-	if err != nil {
-		log.Fatalf("Error marshaling ModifySeatResponse to JSON: %v", err)
+	s, ok := status.FromError(err)
+	if !ok {
+		fmt.Fprintf(stderr, "client %s: %v\n", cmd.name, err)
+		return exitError
+	}
+	fmt.Fprintf(stderr, "client %s: %s (%s)\n", cmd.name, s.Message(), s.Code())
+	if code, ok := exitCodes[s.Code()]; ok {
+		return code
 	}
-	fmt.Printf("ModifySeat Response (Indented JSON):\n%s\n", modifySeatJSON)
+	return exitError
+}
 
+// Helper function to print the overall usage and the list of commands
+func printUsage(w io.Writer, flags *flag.FlagSet) {
+	fmt.Fprintf(w, "Usage: client [flags] <command> [command flags] [arguments]\n")
+	fmt.Fprintf(w, "       client [flags] shell\n\n")
+	fmt.Fprintf(w, "The shell runs commands one line at a time over a single connection.\n\n")
+	printCommands(w)
+	fmt.Fprintf(w, "\nFlags:\n")
+	flags.SetOutput(w)
+	flags.PrintDefaults()
+
+	fmt.Fprint(w, `
+Each flag can also be set with an environment variable named after it, e.g.
+TRAIN_CLIENT_ADDR for -addr, or in the config file, as address. Flags take
+precedence over environment variables, which take precedence over the file.
+
+Exit status:
+  0  success
+  1  other failures
+  2  bad command line
+  3  invalid argument
+  4  not found
+  5  already exists or not allowed in the ticket's current state
+  6  not signed in or not allowed
+  7  server unavailable or timed out
+  8  no seats left
+`)
+}
+
+// Helper function to list the commands, passenger ones first
+func printCommands(w io.Writer) {
+	fmt.Fprintf(w, "Commands:\n")
+	for _, group := range []bool{false, true} {
+		if group {
+			fmt.Fprintf(w, "\nAdmin commands (need -admin-secret or an admin -token):\n")
+		}
+		for _, cmd := range commands {
+			if cmd.admin == group {
+				fmt.Fprintf(w, "  %-18s %s\n", cmd.name, cmd.summary)
+			}
+		}
+	}
 }
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// receiptServer answers ShowReceipt with a receipt, or fails it with err.
+type receiptServer struct {
+	pb.UnimplementedTicketServiceServer
+	err error
+}
+
+func (s *receiptServer) ShowReceipt(ctx context.Context, req *pb.ShowReceiptRequest) (*pb.ShowReceiptResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &pb.ShowReceiptResponse{UserInfo: &pb.Receipt{PurchaseId: req.PurchaseId}}, nil
+}
+
+// Helper function to serve ticketService on a local port and return its
+// address
+func startServer(t *testing.T, ticketService pb.TicketServiceServer) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	server := grpc.NewServer()
+	pb.RegisterTicketServiceServer(server, ticketService)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+// Helper function to point the client at an empty config file, so the
+// user's own is not read
+func ignoreUserConfig(t *testing.T) {
+	t.Helper()
+	empty := filepath.Join(t.TempDir(), "client.yaml")
+	if err := os.WriteFile(empty, nil, 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	t.Setenv("TRAIN_CLIENT_CONFIG", empty)
+}
+
+// Helper function to run a command line and return its exit status and
+// what it wrote to stderr
+func runClient(t *testing.T, args ...string) (int, string) {
+	t.Helper()
+	ignoreUserConfig(t)
+
+	var stdout, stderr bytes.Buffer
+	code := run(args, strings.NewReader(""), &stdout, &stderr)
+	return code, stderr.String()
+}
+
+func TestStatusCodesMapToExitStatuses(t *testing.T) {
+	tests := []struct {
+		code     codes.Code
+		wantExit int
+	}{
+		{codes.OK, exitOK},
+		{codes.InvalidArgument, exitInvalid},
+		{codes.OutOfRange, exitInvalid},
+		{codes.NotFound, exitNotFound},
+		{codes.AlreadyExists, exitConflict},
+		{codes.FailedPrecondition, exitConflict},
+		{codes.Aborted, exitConflict},
+		{codes.Unauthenticated, exitDenied},
+		{codes.PermissionDenied, exitDenied},
+		{codes.Unavailable, exitUnavailable},
+		{codes.DeadlineExceeded, exitUnavailable},
+		{codes.ResourceExhausted, exitExhausted},
+		{codes.Internal, exitError},
+		{codes.Unimplemented, exitError},
+		{codes.Unknown, exitError},
+	}
+	for _, tt := range tests {
+		service := &receiptServer{}
+		if tt.code != codes.OK {
+			service.err = status.Error(tt.code, "call failed")
+		}
+		addr := startServer(t, service)
+
+		exit, stderr := runClient(t, "-addr", addr, "receipt", "p1")
+		if exit != tt.wantExit {
+			t.Errorf("call failing with %v: got exit status %d, want %d (%s)", tt.code, exit, tt.wantExit, stderr)
+		}
+		if tt.code != codes.OK && !strings.Contains(stderr, "call failed ("+tt.code.String()+")") {
+			t.Errorf("call failing with %v: stderr %q does not report the status", tt.code, stderr)
+		}
+	}
+}
+
+func TestCommandLineMistakesExitWithUsage(t *testing.T) {
+	addr := startServer(t, &receiptServer{})
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantExit int
+	}{
+		{"no command", nil, exitUsage},
+		{"unknown command", []string{"-addr", addr, "fly"}, exitUsage},
+		{"unknown flag", []string{"-colour", "red", "trains"}, exitUsage},
+		{"unknown command flag", []string{"-addr", addr, "receipt", "-colour", "red"}, exitUsage},
+		{"too many arguments", []string{"-addr", addr, "receipt", "p1", "p2"}, exitUsage},
+		{"negative timeout", []string{"-addr", addr, "-timeout", "-1s", "receipt", "p1"}, exitUsage},
+		{"unknown output format", []string{"-addr", addr, "-o", "xml", "receipt", "p1"}, exitUsage},
+		{"missing config file", []string{"-config", "/nonexistent/client.yaml", "trains"}, exitUsage},
+		{"help", []string{"help"}, exitOK},
+		{"help for a command", []string{"help", "receipt"}, exitOK},
+		{"-h", []string{"-h"}, exitOK},
+		{"command -h", []string{"receipt", "-h"}, exitOK},
+	}
+	for _, tt := range tests {
+		if exit, stderr := runClient(t, tt.args...); exit != tt.wantExit {
+			t.Errorf("%s: got exit status %d, want %d (%s)", tt.name, exit, tt.wantExit, stderr)
+		}
+	}
+}
+
+func TestReportError(t *testing.T) {
+	cmd := findCommand("receipt")
+
+	tests := []struct {
+		err        error
+		wantExit   int
+		wantStderr string
+	}{
+		{usageError("expected at most one argument: PURCHASE_ID"), exitUsage,
+			"client receipt: expected at most one argument: PURCHASE_ID (run 'client help receipt' for usage)\n"},
+		{status.Error(codes.NotFound, "No ticket found"), exitNotFound, "client receipt: No ticket found (NotFound)\n"},
+		{errors.New("broken pipe"), exitError, "client receipt: broken pipe\n"},
+	}
+	for _, tt := range tests {
+		var stderr bytes.Buffer
+		if exit := reportError(&stderr, cmd, tt.err); exit != tt.wantExit {
+			t.Errorf("%v: got exit status %d, want %d", tt.err, exit, tt.wantExit)
+		}
+		if stderr.String() != tt.wantStderr {
+			t.Errorf("%v: got stderr %q, want %q", tt.err, stderr.String(), tt.wantStderr)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+)
+
+// printer writes responses in the chosen output format.
+type printer struct {
+	format string
+	out    io.Writer
+}
+
+func newPrinter(format string, out io.Writer) (*printer, error) {
+	switch format {
+	case "table", "json", "yaml":
+		return &printer{format: format, out: out}, nil
+	default:
+		return nil, fmt.Errorf("invalid output format %q (expected table, json or yaml)", format)
+	}
+}
+
+// print writes a response.
+func (p *printer) print(message proto.Message) error {
+	switch p.format {
+	case "json":
+		encoded, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", UseProtoNames: true}.Marshal(message)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(p.out, "%s\n", encoded)
+		return err
+	case "yaml":
+		encoded, err := toYAML(message)
+		if err != nil {
+			return err
+		}
+		_, err = p.out.Write(encoded)
+		return err
+	default:
+		return p.printTables(message)
+	}
+}
+
+// printUpdate writes one message of a stream: a line of JSON, a YAML
+// document, or a table for the first message and a line for each after it.
+func (p *printer) printUpdate(update *pb.SeatAvailabilityUpdate) error {
+	switch p.format {
+	case "json":
+		encoded, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(update)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(p.out, "%s\n", encoded)
+		return err
+	case "yaml":
+		encoded, err := toYAML(update)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(p.out, "---\n%s", encoded)
+		return err
+	default:
+		if snapshot := update.GetSnapshot(); snapshot != nil {
+			return p.printTables(snapshot)
+		}
+		change := update.GetChange()
+		state := "free"
+		if change.GetSeat().GetOccupied() {
+			state = "taken"
+		}
+		_, err := fmt.Fprintf(p.out, "%s-%d %s (%s), %d free in section %s\n",
+			change.GetSection(), change.GetSeat().GetSeatNumber(), state, change.GetReason(), change.GetAvailable(), change.GetSection())
+		return err
+	}
+}
+
+// Helper function to render a message as YAML, keeping the field order of
+// its JSON form
+func toYAML(message proto.Message) ([]byte, error) {
+	encoded, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	// JSON is YAML, so decoding it keeps the order; clearing the styles
+	// writes it back out as block YAML
+	var node yaml.Node
+	if err := yaml.Unmarshal(encoded, &node); err != nil {
+		return nil, err
+	}
+	var clearStyle func(*yaml.Node)
+	clearStyle = func(n *yaml.Node) {
+		n.Style = 0
+		for _, child := range n.Content {
+			clearStyle(child)
+		}
+	}
+	clearStyle(&node)
+
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), encoder.Close()
+}
+
+// table is a list of rows under a title, or of field and value pairs when
+// it has no header.
+type table struct {
+	title  string
+	header []string
+	rows   [][]string
+}
+
+// printTables writes a message as a table of its fields followed by a
+// table for each list in it. A response wrapping a single message, such as
+// a receipt, is shown as that message.
+func (p *printer) printTables(message proto.Message) error {
+	m := message.ProtoReflect()
+	for {
+		inner, ok := onlyMessageField(m)
+		if !ok {
+			break
+		}
+		m = inner
+	}
+
+	fields := &table{}
+	var lists []*table
+	flatten(m, "", fields, &lists, false)
+
+	var tables []*table
+	if len(fields.rows) > 0 {
+		tables = append(tables, fields)
+	}
+	tables = append(tables, lists...)
+
+	w := tabwriter.NewWriter(p.out, 0, 4, 2, ' ', 0)
+	for i, t := range tables {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		if len(tables) > 1 && t.title != "" {
+			fmt.Fprintf(w, "%s:\n", t.title)
+		}
+		if t.header != nil {
+			fmt.Fprintln(w, strings.Join(t.header, "\t"))
+		}
+		for _, row := range t.rows {
+			fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+	}
+	return w.Flush()
+}
+
+// Helper function to find the one field of a message when it is a single
+// message other than an amount of money
+func onlyMessageField(m protoreflect.Message) (protoreflect.Message, bool) {
+	var only protoreflect.FieldDescriptor
+	count := 0
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		only = fd
+		count++
+		return true
+	})
+	if count != 1 || only.IsList() || only.IsMap() || only.Message() == nil || isMoney(only.Message()) {
+		return nil, false
+	}
+	return m.Get(only).Message(), true
+}
+
+// Helper function to add the set fields of a message to a field and value
+// table, nested messages as parent.field, and each list of messages to
+// lists. With zeros, unset scalar fields are added too, so rows of a list
+// show false and 0 rather than blanks.
+func flatten(m protoreflect.Message, prefix string, fields *table, lists *[]*table, zeros bool) {
+	descriptors := m.Descriptor().Fields()
+	for i := 0; i < descriptors.Len(); i++ {
+		fd := descriptors.Get(i)
+		scalar := fd.Message() == nil && !fd.IsList() && !fd.IsMap()
+		if (!m.Has(fd) && !(zeros && scalar)) || isDeprecated(fd) {
+			continue
+		}
+		name := prefix + string(fd.Name())
+		value := m.Get(fd)
+
+		switch {
+		case fd.IsMap():
+			var keys []string
+			entries := make(map[string]string)
+			value.Map().Range(func(key protoreflect.MapKey, v protoreflect.Value) bool {
+				keys = append(keys, key.String())
+				entries[key.String()] = formatValue(fd.MapValue(), v)
+				return true
+			})
+			sort.Strings(keys)
+			for _, key := range keys {
+				fields.rows = append(fields.rows, []string{name + "." + key, entries[key]})
+			}
+		case fd.IsList() && fd.Message() != nil && !isMoney(fd.Message()):
+			t, nested := listTable(name, value.List())
+			*lists = append(*lists, t)
+			*lists = append(*lists, nested...)
+		case fd.IsList():
+			items := make([]string, value.List().Len())
+			for j := range items {
+				items[j] = formatValue(fd, value.List().Get(j))
+			}
+			fields.rows = append(fields.rows, []string{name, strings.Join(items, ", ")})
+		case fd.Message() != nil && !isMoney(fd.Message()):
+			flatten(value.Message(), name+".", fields, lists, zeros)
+		default:
+			fields.rows = append(fields.rows, []string{name, formatValue(fd, value)})
+		}
+	}
+}
+
+// Helper function to make a table with a row for each message in a list,
+// and the tables of the lists inside those messages
+func listTable(name string, list protoreflect.List) (*table, []*table) {
+	var columns []string
+	seen := make(map[string]bool)
+	rows := make([]map[string]string, list.Len())
+	var nested []*table
+	for i := range rows {
+		element := &table{}
+		var elementLists []*table
+		flatten(list.Get(i).Message(), "", element, &elementLists, true)
+		rows[i] = make(map[string]string)
+		for _, pair := range element.rows {
+			if !seen[pair[0]] {
+				seen[pair[0]] = true
+				columns = append(columns, pair[0])
+			}
+			rows[i][pair[0]] = pair[1]
+		}
+		for _, sub := range elementLists {
+			sub.title = fmt.Sprintf("%s[%d].%s", name, i, sub.title)
+			nested = append(nested, sub)
+		}
+	}
+	t := &table{title: name}
+	for _, column := range columns {
+		t.header = append(t.header, strings.ToUpper(column))
+	}
+	for _, row := range rows {
+		cells := make([]string, len(columns))
+		for j, column := range columns {
+			cells[j] = row[column]
+		}
+		t.rows = append(t.rows, cells)
+	}
+	return t, nested
+}
+
+// Helper function to check whether a message type is an amount of money
+func isMoney(md protoreflect.MessageDescriptor) bool {
+	return md.FullName() == (&pb.Money{}).ProtoReflect().Descriptor().FullName()
+}
+
+// Helper function to check whether a field is deprecated
+func isDeprecated(fd protoreflect.FieldDescriptor) bool {
+	options, ok := fd.Options().(interface{ GetDeprecated() bool })
+	return ok && options.GetDeprecated()
+}
+
+// Helper function to show a single value of a field: amounts of money in
+// major units, and enums without their type prefix
+func formatValue(fd protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch {
+	case fd.Message() != nil && isMoney(fd.Message()):
+		money := value.Message().Interface().(*pb.Money)
+		return fmt.Sprintf("%.2f %s", float64(money.AmountMinor)/100, money.Currency)
+	case fd.Message() != nil:
+		encoded, _ := protojson.MarshalOptions{UseProtoNames: true}.Marshal(value.Message().Interface())
+		return string(encoded)
+	case fd.Enum() != nil:
+		values := fd.Enum().Values()
+		v := values.ByNumber(value.Enum())
+		if v == nil {
+			return fmt.Sprint(value.Enum())
+		}
+		prefix := strings.TrimSuffix(string(values.Get(0).Name()), "UNSPECIFIED")
+		prefix = strings.TrimSuffix(prefix, "ANY")
+		return strings.TrimPrefix(string(v.Name()), prefix)
+	default:
+		return value.String()
+	}
+}
@@ -0,0 +1,527 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"unicode"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"golang.org/x/term"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const shellPrompt = "train> "
+
+// How many values of each kind the shell remembers for completion
+const maxRemembered = 50
+
+// Commands of the shell itself, besides the client's
+var shellBuiltins = []string{"help", "history", "exit", "quit"}
+
+// Kinds of values the shell completes
+const (
+	seenEmail    = "email"
+	seenPurchase = "purchase"
+	seenBooking  = "booking"
+	seenHold     = "hold"
+	seenSection  = "section"
+	seenStation  = "station"
+	seenTrain    = "train"
+)
+
+// The kind of value held by each response field worth remembering
+var rememberedFields = map[protoreflect.Name]string{
+	"email":               seenEmail,
+	"booked_by":           seenEmail,
+	"purchase_id":         seenPurchase,
+	"purchase_id_a":       seenPurchase,
+	"purchase_id_b":       seenPurchase,
+	"with_purchase_id":    seenPurchase,
+	"next_to_purchase_id": seenPurchase,
+	"booking_id":          seenBooking,
+	"hold_id":             seenHold,
+	"section":             seenSection,
+	"sections":            seenSection,
+	"station":             seenStation,
+	"from":                seenStation,
+	"to":                  seenStation,
+	"train_id":            seenTrain,
+}
+
+// shell is an interactive session that runs every command over one
+// connection.
+type shell struct {
+	c      *cli
+	stderr io.Writer
+	// Lines run so far
+	history []string
+	// Values seen in responses by kind, most recent first
+	seen map[string][]string
+	// Exit status of the last command
+	status int
+}
+
+// runShell starts the shell, reading commands from stdin until it ends or
+// the user leaves.
+func runShell(opts *options, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("client shell", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		printShellHelp(stderr)
+	}
+	opts.register(fs)
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if len(positional) > 0 {
+		fmt.Fprintf(stderr, "client shell: takes no arguments\n")
+		return exitUsage
+	}
+
+	c, conn, code := connect(opts, stdout, stderr)
+	if code != exitOK {
+		return code
+	}
+	defer conn.Close()
+
+	sh := &shell{c: c, stderr: stderr, seen: make(map[string][]string)}
+	ctx := context.Background()
+
+	// Learn the trains, stations and sections to complete; the shell works
+	// without them if the server cannot be reached yet
+	if cmd := findCommand("trains"); cmd != nil {
+		if message, err := c.call(ctx, cmd, cmd.setup(cmd.flagSet(io.Discard)), nil); err == nil {
+			sh.remember(message)
+		}
+	}
+
+	// Read plain lines when the input is not a terminal, e.g. a script
+	file, ok := stdin.(*os.File)
+	if !ok || !term.IsTerminal(int(file.Fd())) {
+		scanner := bufio.NewScanner(stdin)
+		for scanner.Scan() {
+			if !sh.runLine(ctx, scanner.Text()) {
+				break
+			}
+		}
+		return sh.status
+	}
+
+	fd := int(file.Fd())
+	t := term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{stdin, stdout}, shellPrompt)
+	if width, height, err := term.GetSize(fd); err == nil && width > 0 {
+		t.SetSize(width, height)
+	}
+	t.AutoCompleteCallback = func(line string, pos int, key rune) (string, int, bool) {
+		if key != '\t' {
+			return "", 0, false
+		}
+		return sh.complete(t, line, pos)
+	}
+
+	fmt.Fprintf(stdout, "Connected to %s. Type help for the commands, exit or Ctrl-D to leave.\n", c.address)
+	for {
+		// The terminal is raw only while a line is typed, so commands print
+		// as usual and Ctrl-C interrupts them
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			fmt.Fprintf(stderr, "client shell: %v\n", err)
+			return exitError
+		}
+		line, err := t.ReadLine()
+		term.Restore(fd, state)
+		if err != nil {
+			fmt.Fprintln(stdout)
+			return sh.status
+		}
+		if !sh.runLine(ctx, line) {
+			return sh.status
+		}
+	}
+}
+
+// runLine runs one line of input and reports whether to keep reading.
+func (sh *shell) runLine(ctx context.Context, line string) bool {
+	words, err := splitWords(line)
+	if err != nil {
+		fmt.Fprintf(sh.stderr, "client shell: %v\n", err)
+		sh.status = exitUsage
+		return true
+	}
+	if len(words) == 0 {
+		return true
+	}
+	sh.history = append(sh.history, line)
+
+	out := sh.c.printer.out
+	switch words[0] {
+	case "exit", "quit":
+		return false
+	case "history":
+		for i, previous := range sh.history {
+			fmt.Fprintf(out, "%4d  %s\n", i+1, previous)
+		}
+		sh.status = exitOK
+		return true
+	case "help":
+		sh.status = exitOK
+		if len(words) > 1 {
+			if cmd := findCommand(words[1]); cmd != nil {
+				cmd.flagSet(out).Usage()
+				return true
+			}
+		}
+		printShellHelp(out)
+		return true
+	}
+
+	cmd := findCommand(words[0])
+	if cmd == nil {
+		fmt.Fprintf(sh.stderr, "client: unknown command %q (type help for the list)\n", words[0])
+		sh.status = exitUsage
+		return true
+	}
+	sh.status = sh.runCommand(ctx, cmd, words[1:])
+	return true
+}
+
+// runCommand runs a client command on the shell's connection and returns its
+// exit status.
+func (sh *shell) runCommand(ctx context.Context, cmd *command, args []string) int {
+	// A line may set the shared flags for itself, except how to connect
+	opts := sh.c.options()
+	fs := cmd.flagSet(sh.stderr)
+	call := cmd.setup(fs)
+	opts.register(fs)
+	positional, err := parseArgs(fs, args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if opts.Address != sh.c.address || opts.TLS != sh.c.tls {
+		fmt.Fprintf(sh.stderr, "client %s: -addr and the TLS flags cannot change in the shell; start another one\n", cmd.name)
+		return exitUsage
+	}
+	printer, err := opts.printer(sh.c.printer.out)
+	if err != nil {
+		fmt.Fprintf(sh.stderr, "client %s: %v\n", cmd.name, err)
+		return exitUsage
+	}
+
+	c := *sh.c
+	c.printer = printer
+	c.timeout = opts.Timeout
+	c.token = opts.Token
+	c.adminSecret = opts.AdminSecret
+
+	// Ctrl-C stops the command, such as watch, and returns to the prompt
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	message, code := c.execute(ctx, cmd, call, positional, sh.stderr)
+
+	// Keep signing in as the session did, with the admin token got on its
+	// behalf, or as whoever logged in
+	if opts.Token == sh.c.token && opts.AdminSecret == sh.c.adminSecret {
+		sh.c.token = c.token
+	}
+	if login, ok := message.(*pb.LoginResponse); ok {
+		sh.c.token = login.AccessToken
+	}
+	if message != nil {
+		sh.remember(message)
+	}
+	return code
+}
+
+// remember notes the values in a response worth completing later.
+func (sh *shell) remember(message proto.Message) {
+	var walk func(m protoreflect.Message)
+	walk = func(m protoreflect.Message) {
+		m.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+			switch {
+			case fd.Message() != nil && fd.IsList():
+				for i := 0; i < value.List().Len(); i++ {
+					walk(value.List().Get(i).Message())
+				}
+			case fd.Message() != nil && !fd.IsMap():
+				walk(value.Message())
+			case fd.Kind() == protoreflect.StringKind && !fd.IsMap():
+				kind, ok := rememberedFields[fd.Name()]
+				if fd.Name() == "id" && m.Descriptor().Name() == "Train" {
+					kind, ok = seenTrain, true
+				}
+				if !ok {
+					break
+				}
+				if fd.IsList() {
+					for i := 0; i < value.List().Len(); i++ {
+						sh.see(kind, value.List().Get(i).String())
+					}
+				} else {
+					sh.see(kind, value.String())
+				}
+			}
+			return true
+		})
+	}
+	walk(message.ProtoReflect())
+}
+
+// Helper function to note a value as the most recently seen of its kind
+func (sh *shell) see(kind, value string) {
+	if value == "" {
+		return
+	}
+	values := []string{value}
+	for _, previous := range sh.seen[kind] {
+		if previous != value && len(values) < maxRemembered {
+			values = append(values, previous)
+		}
+	}
+	sh.seen[kind] = values
+}
+
+// complete handles a tab: it completes the word before the cursor if only
+// one value fits, or as far as every value agrees, and otherwise lists the
+// values that fit.
+func (sh *shell) complete(t *term.Terminal, line string, pos int) (string, int, bool) {
+	words, start, open, _ := scanWords(line[:pos])
+	current := ""
+	if open {
+		current = words[len(words)-1]
+		words = words[:len(words)-1]
+	} else {
+		start = pos
+	}
+
+	// Complete the value of a -flag=value word after the =
+	var candidates []string
+	if name, value, ok := strings.Cut(current, "="); ok && strings.HasPrefix(current, "-") && len(words) > 0 {
+		candidates = sh.flagValues(words[0], strings.TrimLeft(name, "-"))
+		start += strings.Index(line[start:pos], "=") + 1
+		current = value
+	} else {
+		candidates = sh.candidates(words, current)
+	}
+
+	var matches []string
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, current) {
+			matches = append(matches, candidate)
+		}
+	}
+	if len(matches) == 0 {
+		return "", 0, false
+	}
+
+	completion := quoteWord(matches[0]) + " "
+	if len(matches) > 1 {
+		common := matches[0]
+		for _, match := range matches[1:] {
+			for !strings.HasPrefix(match, common) {
+				common = common[:len(common)-1]
+			}
+		}
+		if len(common) == len(current) {
+			t.Write([]byte(strings.Join(matches, "  ") + "\n"))
+			return "", 0, false
+		}
+		completion = quoteWord(common)
+		if strings.HasSuffix(completion, "'") && !strings.HasSuffix(common, "'") {
+			// Leave the quote open so the word can still be finished
+			completion = completion[:len(completion)-1]
+		}
+	}
+	return line[:start] + completion + line[pos:], start + len(completion), true
+}
+
+// Helper function to find what may come next on a line after the given
+// words
+func (sh *shell) candidates(words []string, current string) []string {
+	if len(words) == 0 {
+		names := append([]string{}, shellBuiltins...)
+		for _, cmd := range commands {
+			names = append(names, cmd.name)
+		}
+		sort.Strings(names)
+		return names
+	}
+	if words[0] == "help" {
+		if len(words) > 1 {
+			return nil
+		}
+		names := make([]string, len(commands))
+		for i, cmd := range commands {
+			names[i] = cmd.name
+		}
+		return names
+	}
+	cmd := findCommand(words[0])
+	if cmd == nil {
+		return nil
+	}
+
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	cmd.setup(fs)
+	sh.c.options().register(fs)
+	if strings.HasPrefix(current, "-") {
+		var names []string
+		fs.VisitAll(func(f *flag.Flag) {
+			names = append(names, "-"+f.Name)
+		})
+		return names
+	}
+
+	// The value of the flag before, unless it is a switch
+	if previous := words[len(words)-1]; len(words) > 1 && strings.HasPrefix(previous, "-") && !strings.Contains(previous, "=") {
+		name := strings.TrimLeft(previous, "-")
+		if f := fs.Lookup(name); f != nil {
+			if boolFlag, ok := f.Value.(interface{ IsBoolFlag() bool }); !ok || !boolFlag.IsBoolFlag() {
+				return sh.flagValues(cmd.name, name)
+			}
+		}
+	}
+
+	switch {
+	case strings.Contains(cmd.args, "PURCHASE_ID"):
+		return sh.seen[seenPurchase]
+	case strings.Contains(cmd.args, "HOLD_ID"):
+		return sh.seen[seenHold]
+	default:
+		return nil
+	}
+}
+
+// Helper function to find the values a flag of a command may take
+func (sh *shell) flagValues(commandName, flagName string) []string {
+	switch flagName {
+	case "email":
+		return sh.seen[seenEmail]
+	case "section":
+		return sh.seen[seenSection]
+	case "from", "to":
+		if commandName == "move-section" {
+			return sh.seen[seenSection]
+		}
+		return sh.seen[seenStation]
+	case "train":
+		return sh.seen[seenTrain]
+	case "booking":
+		return sh.seen[seenBooking]
+	case "next-to":
+		return sh.seen[seenPurchase]
+	case "position":
+		return []string{"window", "aisle", "any"}
+	case "output", "o":
+		return []string{"table", "json", "yaml"}
+	default:
+		return nil
+	}
+}
+
+// splitWords splits a line into words the way a shell does: quotes keep
+// spaces inside a word and a backslash escapes the next character.
+func splitWords(line string) ([]string, error) {
+	words, _, _, err := scanWords(line)
+	return words, err
+}
+
+// Helper function to split a line into words. It also returns where the
+// last word starts and whether that word is still open, that is, the line
+// does not end between words. The words are returned even when the line
+// ends inside quotes, for completion.
+func scanWords(line string) (words []string, last int, open bool, err error) {
+	var word strings.Builder
+	var quote rune
+	escaped := false
+	startWord := func(i int) {
+		if !open {
+			open = true
+			last = i
+		}
+	}
+	for i, r := range line {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			startWord(i)
+			escaped = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			startWord(i)
+			quote = r
+		case unicode.IsSpace(r):
+			if open {
+				words = append(words, word.String())
+				word.Reset()
+				open = false
+			}
+		default:
+			startWord(i)
+			word.WriteRune(r)
+		}
+	}
+	if open {
+		words = append(words, word.String())
+	}
+	if quote != 0 || escaped {
+		err = errors.New("unterminated quote or escape")
+	}
+	return words, last, open, err
+}
+
+// Helper function to quote a word for the shell if it needs it
+func quoteWord(word string) string {
+	if word != "" && !strings.ContainsAny(word, " \t\"'\\") {
+		return word
+	}
+	return "'" + strings.ReplaceAll(word, "'", `'\''`) + "'"
+}
+
+// Helper function to print the help of the shell
+func printShellHelp(w io.Writer) {
+	fmt.Fprintf(w, `Usage: client [flags] shell
+
+Runs commands one line at a time over a single connection. Type a command
+as you would after "client", e.g. receipt -email jo@example.com; the shared
+flags apply to the whole session, and a line may set them for itself.
+
+`)
+	printCommands(w)
+	fmt.Fprint(w, `
+Shell commands:
+  help [COMMAND]     show this help, or the usage of a command
+  history            list the lines run in this session
+  exit, quit         leave the shell; so does Ctrl-D
+
+Tab completes command names, flags, trains, stations and sections, and the
+emails and purchase ids seen in earlier responses. Up and down recall
+earlier lines. Ctrl-C stops a running command, such as watch.
+`)
+}
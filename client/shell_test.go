@@ -0,0 +1,172 @@
+package main
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"golang.org/x/term"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Helper function to make a shell that is not connected, for completion
+func newTestShell() *shell {
+	return &shell{
+		c:    &cli{printer: &printer{format: "table"}, timeout: defaultTimeout},
+		seen: make(map[string][]string),
+	}
+}
+
+func TestSplitWords(t *testing.T) {
+	tests := []struct {
+		line    string
+		want    []string
+		wantErr bool
+	}{
+		{"", nil, false},
+		{"  receipt   p1  ", []string{"receipt", "p1"}, false},
+		{`purchase -passenger "Jo Bloggs <jo@example.com>"`, []string{"purchase", "-passenger", "Jo Bloggs <jo@example.com>"}, false},
+		{`cancel -reason 'can'\''t travel'`, []string{"cancel", "-reason", "can't travel"}, false},
+		{`receipt a\ b`, []string{"receipt", "a b"}, false},
+		{`receipt ""`, []string{"receipt", ""}, false},
+		{`receipt 'p1`, []string{"receipt", "p1"}, true},
+		{`receipt p1\`, []string{"receipt", "p1"}, true},
+	}
+	for _, tt := range tests {
+		got, err := splitWords(tt.line)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("splitWords(%q): got error %v, want error %v", tt.line, err, tt.wantErr)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("splitWords(%q): got %q, want %q", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestShellRemembersResponseValues(t *testing.T) {
+	sh := newTestShell()
+	sh.remember(&pb.ListTrainsResponse{Trains: []*pb.Train{{
+		Id:       "T1",
+		Stops:    []*pb.Stop{{Station: "London"}, {Station: "Paris"}},
+		Sections: []string{"A", "B"},
+	}}})
+	sh.remember(&pb.ShowReceiptResponse{UserInfo: &pb.Receipt{PurchaseId: "p1", BookedBy: "jo@example.com"}})
+	sh.remember(&pb.ShowReceiptResponse{UserInfo: &pb.Receipt{PurchaseId: "p2", User: &pb.User{Email: "jo@example.com"}}})
+
+	tests := []struct {
+		kind string
+		want []string
+	}{
+		{seenTrain, []string{"T1"}},
+		{seenStation, []string{"Paris", "London"}},
+		{seenSection, []string{"B", "A"}},
+		{seenPurchase, []string{"p2", "p1"}},
+		{seenEmail, []string{"jo@example.com"}},
+		{seenHold, nil},
+	}
+	for _, tt := range tests {
+		if got := sh.seen[tt.kind]; !slices.Equal(got, tt.want) {
+			t.Errorf("seen %s: got %q, want %q, most recent first", tt.kind, got, tt.want)
+		}
+	}
+}
+
+func TestShellCompletion(t *testing.T) {
+	sh := newTestShell()
+	sh.seen[seenPurchase] = []string{"purchase-2", "purchase-1"}
+	sh.seen[seenEmail] = []string{"jo@example.com"}
+	sh.seen[seenStation] = []string{"London", "Paris"}
+	sh.seen[seenSection] = []string{"A", "B"}
+	sh.seen[seenHold] = []string{"hold one"}
+
+	tests := []struct {
+		line     string
+		want     string
+		wantList string
+	}{
+		{"rece", "receipt ", ""},
+		{"he", "help ", ""},
+		{"help mod", "help modify ", ""},
+		{"wait", "waitlist-", ""},
+		{"waitlist-", "", "waitlist-join  waitlist-leave  waitlist-position"},
+		{"receipt purchase-", "", "purchase-2  purchase-1"},
+		{"receipt purchase-1", "receipt purchase-1 ", ""},
+		{"receipt -ema", "receipt -email ", ""},
+		{"receipt -email j", "receipt -email jo@example.com ", ""},
+		{"receipt -email=j", "receipt -email=jo@example.com ", ""},
+		{"search -from L", "search -from London ", ""},
+		{"move-section -from ", "", "A  B"},
+		{"allocate -position w", "allocate -position window ", ""},
+		{"confirm-hold h", "confirm-hold 'hold one' ", ""},
+		{"receipt -o y", "receipt -o yaml ", ""},
+		{"unknown p", "", ""},
+		{"receipt x", "", ""},
+	}
+	for _, tt := range tests {
+		var out bytes.Buffer
+		terminal := term.NewTerminal(struct {
+			*bytes.Buffer
+		}{&out}, "")
+		got, pos, ok := sh.complete(terminal, tt.line, len(tt.line))
+		if tt.want == "" {
+			if ok {
+				t.Errorf("complete(%q): got %q, want no completion", tt.line, got)
+			}
+		} else if !ok || got != tt.want || pos != len(tt.want) {
+			t.Errorf("complete(%q): got %q at %d (%v), want %q", tt.line, got, pos, ok, tt.want)
+		}
+		if listed := strings.TrimSpace(out.String()); listed != tt.wantList {
+			t.Errorf("complete(%q): listed %q, want %q", tt.line, listed, tt.wantList)
+		}
+	}
+}
+
+func TestShellScript(t *testing.T) {
+	addr := startServer(t, &receiptServer{})
+	ignoreUserConfig(t)
+
+	tests := []struct {
+		name       string
+		script     string
+		wantExit   int
+		wantStdout []string
+	}{
+		{"last command succeeds", "fly\nreceipt -o yaml p1\n", exitOK, []string{"purchase_id: p1"}},
+		{"last command fails", "receipt p1\nreceipt p1 p2\n", exitUsage, nil},
+		{"unknown command", "fly\n", exitUsage, nil},
+		{"unterminated quote", "receipt 'p1\n", exitUsage, nil},
+		{"history", "receipt p1\n\nhistory\n", exitOK, []string{"   1  receipt p1\n   2  history\n"}},
+		{"exit stops reading", "exit\nfly\n", exitOK, nil},
+		{"connection flags are fixed", "receipt -addr localhost:1 p1\n", exitUsage, nil},
+		{"help", "help receipt\n", exitOK, []string{"Usage: client [flags] receipt"}},
+	}
+	for _, tt := range tests {
+		var stdout, stderr bytes.Buffer
+		exit := run([]string{"-addr", addr, "shell"}, strings.NewReader(tt.script), &stdout, &stderr)
+		if exit != tt.wantExit {
+			t.Errorf("%s: got exit status %d, want %d (%s)", tt.name, exit, tt.wantExit, stderr.String())
+		}
+		for _, want := range tt.wantStdout {
+			if !strings.Contains(stdout.String(), want) {
+				t.Errorf("%s: stdout %q does not contain %q", tt.name, stdout.String(), want)
+			}
+		}
+	}
+}
+
+func TestShellKeepsGoingAfterFailedCalls(t *testing.T) {
+	addr := startServer(t, &receiptServer{err: status.Error(codes.NotFound, "No ticket found")})
+	ignoreUserConfig(t)
+
+	var stdout, stderr bytes.Buffer
+	exit := run([]string{"-addr", addr, "shell"}, strings.NewReader("receipt p1\nreceipt p2\n"), &stdout, &stderr)
+	if exit != exitNotFound {
+		t.Fatalf("got exit status %d, want %d from the last command", exit, exitNotFound)
+	}
+	if got := strings.Count(stderr.String(), "No ticket found"); got != 2 {
+		t.Fatalf("stderr %q reports %d failed calls, want 2", stderr.String(), got)
+	}
+}
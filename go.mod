@@ -3,9 +3,11 @@ module github.com/harshithvh/go_gRPC
 go 1.21.5
 
 require (
+	github.com/google/uuid v1.5.0
+	golang.org/x/term v0.15.0
 	google.golang.org/grpc v1.60.1
 	google.golang.org/protobuf v1.32.0
-	github.com/google/uuid v1.5.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
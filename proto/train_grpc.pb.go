@@ -28,6 +28,22 @@ type TicketServiceClient interface {
 	GetUsersBySection(ctx context.Context, in *GetUsersBySectionRequest, opts ...grpc.CallOption) (*GetUsersBySectionResponse, error)
 	RemoveUser(ctx context.Context, in *RemoveUserRequest, opts ...grpc.CallOption) (*RemoveUserResponse, error)
 	ModifySeat(ctx context.Context, in *ModifySeatRequest, opts ...grpc.CallOption) (*ModifySeatResponse, error)
+	ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error)
+	HoldSeat(ctx context.Context, in *HoldSeatRequest, opts ...grpc.CallOption) (*HoldSeatResponse, error)
+	ConfirmHold(ctx context.Context, in *ConfirmHoldRequest, opts ...grpc.CallOption) (*ConfirmHoldResponse, error)
+	JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error)
+	LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error)
+	GetWaitlistPosition(ctx context.Context, in *GetWaitlistPositionRequest, opts ...grpc.CallOption) (*GetWaitlistPositionResponse, error)
+	WatchSeatAvailability(ctx context.Context, in *WatchSeatAvailabilityRequest, opts ...grpc.CallOption) (TicketService_WatchSeatAvailabilityClient, error)
+	CancelTicket(ctx context.Context, in *CancelTicketRequest, opts ...grpc.CallOption) (*CancelTicketResponse, error)
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error)
+	Board(ctx context.Context, in *BoardRequest, opts ...grpc.CallOption) (*BoardResponse, error)
+	SwapSeats(ctx context.Context, in *SwapSeatsRequest, opts ...grpc.CallOption) (*SwapSeatsResponse, error)
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	GetSeatMap(ctx context.Context, in *GetSeatMapRequest, opts ...grpc.CallOption) (*GetSeatMapResponse, error)
+	ListTrains(ctx context.Context, in *ListTrainsRequest, opts ...grpc.CallOption) (*ListTrainsResponse, error)
+	SearchJourneys(ctx context.Context, in *SearchJourneysRequest, opts ...grpc.CallOption) (*SearchJourneysResponse, error)
+	QuoteFare(ctx context.Context, in *QuoteFareRequest, opts ...grpc.CallOption) (*QuoteFareResponse, error)
 }
 
 type ticketServiceClient struct {
@@ -92,6 +108,173 @@ func (c *ticketServiceClient) ModifySeat(ctx context.Context, in *ModifySeatRequ
 	return out, nil
 }
 
+func (c *ticketServiceClient) ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error) {
+	out := new(ListBookingsResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketService/ListBookings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) HoldSeat(ctx context.Context, in *HoldSeatRequest, opts ...grpc.CallOption) (*HoldSeatResponse, error) {
+	out := new(HoldSeatResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketService/HoldSeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ConfirmHold(ctx context.Context, in *ConfirmHoldRequest, opts ...grpc.CallOption) (*ConfirmHoldResponse, error) {
+	out := new(ConfirmHoldResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketService/ConfirmHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error) {
+	out := new(JoinWaitlistResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketService/JoinWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error) {
+	out := new(LeaveWaitlistResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketService/LeaveWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetWaitlistPosition(ctx context.Context, in *GetWaitlistPositionRequest, opts ...grpc.CallOption) (*GetWaitlistPositionResponse, error) {
+	out := new(GetWaitlistPositionResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketService/GetWaitlistPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) WatchSeatAvailability(ctx context.Context, in *WatchSeatAvailabilityRequest, opts ...grpc.CallOption) (TicketService_WatchSeatAvailabilityClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TicketService_ServiceDesc.Streams[0], "/ticket_service.TicketService/WatchSeatAvailability", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ticketServiceWatchSeatAvailabilityClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TicketService_WatchSeatAvailabilityClient interface {
+	Recv() (*SeatAvailabilityUpdate, error)
+	grpc.ClientStream
+}
+
+type ticketServiceWatchSeatAvailabilityClient struct {
+	grpc.ClientStream
+}
+
+func (x *ticketServiceWatchSeatAvailabilityClient) Recv() (*SeatAvailabilityUpdate, error) {
+	m := new(SeatAvailabilityUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ticketServiceClient) CancelTicket(ctx context.Context, in *CancelTicketRequest, opts ...grpc.CallOption) (*CancelTicketResponse, error) {
+	out := new(CancelTicketResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketService/CancelTicket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error) {
+	out := new(CheckInResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketService/CheckIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) Board(ctx context.Context, in *BoardRequest, opts ...grpc.CallOption) (*BoardResponse, error) {
+	out := new(BoardResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketService/Board", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) SwapSeats(ctx context.Context, in *SwapSeatsRequest, opts ...grpc.CallOption) (*SwapSeatsResponse, error) {
+	out := new(SwapSeatsResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketService/SwapSeats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketService/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetSeatMap(ctx context.Context, in *GetSeatMapRequest, opts ...grpc.CallOption) (*GetSeatMapResponse, error) {
+	out := new(GetSeatMapResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketService/GetSeatMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListTrains(ctx context.Context, in *ListTrainsRequest, opts ...grpc.CallOption) (*ListTrainsResponse, error) {
+	out := new(ListTrainsResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketService/ListTrains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) SearchJourneys(ctx context.Context, in *SearchJourneysRequest, opts ...grpc.CallOption) (*SearchJourneysResponse, error) {
+	out := new(SearchJourneysResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketService/SearchJourneys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) QuoteFare(ctx context.Context, in *QuoteFareRequest, opts ...grpc.CallOption) (*QuoteFareResponse, error) {
+	out := new(QuoteFareResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketService/QuoteFare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TicketServiceServer is the server API for TicketService service.
 // All implementations must embed UnimplementedTicketServiceServer
 // for forward compatibility
@@ -102,6 +285,22 @@ type TicketServiceServer interface {
 	GetUsersBySection(context.Context, *GetUsersBySectionRequest) (*GetUsersBySectionResponse, error)
 	RemoveUser(context.Context, *RemoveUserRequest) (*RemoveUserResponse, error)
 	ModifySeat(context.Context, *ModifySeatRequest) (*ModifySeatResponse, error)
+	ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsResponse, error)
+	HoldSeat(context.Context, *HoldSeatRequest) (*HoldSeatResponse, error)
+	ConfirmHold(context.Context, *ConfirmHoldRequest) (*ConfirmHoldResponse, error)
+	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error)
+	LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error)
+	GetWaitlistPosition(context.Context, *GetWaitlistPositionRequest) (*GetWaitlistPositionResponse, error)
+	WatchSeatAvailability(*WatchSeatAvailabilityRequest, TicketService_WatchSeatAvailabilityServer) error
+	CancelTicket(context.Context, *CancelTicketRequest) (*CancelTicketResponse, error)
+	CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error)
+	Board(context.Context, *BoardRequest) (*BoardResponse, error)
+	SwapSeats(context.Context, *SwapSeatsRequest) (*SwapSeatsResponse, error)
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	GetSeatMap(context.Context, *GetSeatMapRequest) (*GetSeatMapResponse, error)
+	ListTrains(context.Context, *ListTrainsRequest) (*ListTrainsResponse, error)
+	SearchJourneys(context.Context, *SearchJourneysRequest) (*SearchJourneysResponse, error)
+	QuoteFare(context.Context, *QuoteFareRequest) (*QuoteFareResponse, error)
 	mustEmbedUnimplementedTicketServiceServer()
 }
 
@@ -127,6 +326,54 @@ func (UnimplementedTicketServiceServer) RemoveUser(context.Context, *RemoveUserR
 func (UnimplementedTicketServiceServer) ModifySeat(context.Context, *ModifySeatRequest) (*ModifySeatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModifySeat not implemented")
 }
+func (UnimplementedTicketServiceServer) ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookings not implemented")
+}
+func (UnimplementedTicketServiceServer) HoldSeat(context.Context, *HoldSeatRequest) (*HoldSeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldSeat not implemented")
+}
+func (UnimplementedTicketServiceServer) ConfirmHold(context.Context, *ConfirmHoldRequest) (*ConfirmHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmHold not implemented")
+}
+func (UnimplementedTicketServiceServer) JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (UnimplementedTicketServiceServer) LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveWaitlist not implemented")
+}
+func (UnimplementedTicketServiceServer) GetWaitlistPosition(context.Context, *GetWaitlistPositionRequest) (*GetWaitlistPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaitlistPosition not implemented")
+}
+func (UnimplementedTicketServiceServer) WatchSeatAvailability(*WatchSeatAvailabilityRequest, TicketService_WatchSeatAvailabilityServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSeatAvailability not implemented")
+}
+func (UnimplementedTicketServiceServer) CancelTicket(context.Context, *CancelTicketRequest) (*CancelTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTicket not implemented")
+}
+func (UnimplementedTicketServiceServer) CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (UnimplementedTicketServiceServer) Board(context.Context, *BoardRequest) (*BoardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Board not implemented")
+}
+func (UnimplementedTicketServiceServer) SwapSeats(context.Context, *SwapSeatsRequest) (*SwapSeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapSeats not implemented")
+}
+func (UnimplementedTicketServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedTicketServiceServer) GetSeatMap(context.Context, *GetSeatMapRequest) (*GetSeatMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeatMap not implemented")
+}
+func (UnimplementedTicketServiceServer) ListTrains(context.Context, *ListTrainsRequest) (*ListTrainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrains not implemented")
+}
+func (UnimplementedTicketServiceServer) SearchJourneys(context.Context, *SearchJourneysRequest) (*SearchJourneysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchJourneys not implemented")
+}
+func (UnimplementedTicketServiceServer) QuoteFare(context.Context, *QuoteFareRequest) (*QuoteFareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteFare not implemented")
+}
 func (UnimplementedTicketServiceServer) mustEmbedUnimplementedTicketServiceServer() {}
 
 // UnsafeTicketServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -248,36 +495,731 @@ func _TicketService_ModifySeat_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-// TicketService_ServiceDesc is the grpc.ServiceDesc for TicketService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var TicketService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "ticket_service.TicketService",
-	HandlerType: (*TicketServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PurchaseTicket",
-			Handler:    _TicketService_PurchaseTicket_Handler,
-		},
-		{
-			MethodName: "AllocateSeat",
-			Handler:    _TicketService_AllocateSeat_Handler,
-		},
-		{
-			MethodName: "ShowReceipt",
-			Handler:    _TicketService_ShowReceipt_Handler,
-		},
-		{
-			MethodName: "GetUsersBySection",
-			Handler:    _TicketService_GetUsersBySection_Handler,
-		},
-		{
-			MethodName: "RemoveUser",
-			Handler:    _TicketService_RemoveUser_Handler,
-		},
-		{
-			MethodName: "ModifySeat",
-			Handler:    _TicketService_ModifySeat_Handler,
+func _TicketService_ListBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketService/ListBookings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListBookings(ctx, req.(*ListBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_HoldSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldSeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).HoldSeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketService/HoldSeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).HoldSeat(ctx, req.(*HoldSeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ConfirmHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ConfirmHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketService/ConfirmHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ConfirmHold(ctx, req.(*ConfirmHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).JoinWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketService/JoinWaitlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).JoinWaitlist(ctx, req.(*JoinWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_LeaveWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).LeaveWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketService/LeaveWaitlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).LeaveWaitlist(ctx, req.(*LeaveWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetWaitlistPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaitlistPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetWaitlistPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketService/GetWaitlistPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetWaitlistPosition(ctx, req.(*GetWaitlistPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_WatchSeatAvailability_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSeatAvailabilityRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TicketServiceServer).WatchSeatAvailability(m, &ticketServiceWatchSeatAvailabilityServer{stream})
+}
+
+type TicketService_WatchSeatAvailabilityServer interface {
+	Send(*SeatAvailabilityUpdate) error
+	grpc.ServerStream
+}
+
+type ticketServiceWatchSeatAvailabilityServer struct {
+	grpc.ServerStream
+}
+
+func (x *ticketServiceWatchSeatAvailabilityServer) Send(m *SeatAvailabilityUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TicketService_CancelTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CancelTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketService/CancelTicket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CancelTicket(ctx, req.(*CancelTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketService/CheckIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_Board_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).Board(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketService/Board",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).Board(ctx, req.(*BoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_SwapSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).SwapSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketService/SwapSeats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).SwapSeats(ctx, req.(*SwapSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketService/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetSeatMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeatMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetSeatMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketService/GetSeatMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetSeatMap(ctx, req.(*GetSeatMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListTrains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListTrains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketService/ListTrains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListTrains(ctx, req.(*ListTrainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_SearchJourneys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchJourneysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).SearchJourneys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketService/SearchJourneys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).SearchJourneys(ctx, req.(*SearchJourneysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_QuoteFare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteFareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).QuoteFare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketService/QuoteFare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).QuoteFare(ctx, req.(*QuoteFareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TicketService_ServiceDesc is the grpc.ServiceDesc for TicketService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TicketService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ticket_service.TicketService",
+	HandlerType: (*TicketServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PurchaseTicket",
+			Handler:    _TicketService_PurchaseTicket_Handler,
+		},
+		{
+			MethodName: "AllocateSeat",
+			Handler:    _TicketService_AllocateSeat_Handler,
+		},
+		{
+			MethodName: "ShowReceipt",
+			Handler:    _TicketService_ShowReceipt_Handler,
+		},
+		{
+			MethodName: "GetUsersBySection",
+			Handler:    _TicketService_GetUsersBySection_Handler,
+		},
+		{
+			MethodName: "RemoveUser",
+			Handler:    _TicketService_RemoveUser_Handler,
+		},
+		{
+			MethodName: "ModifySeat",
+			Handler:    _TicketService_ModifySeat_Handler,
+		},
+		{
+			MethodName: "ListBookings",
+			Handler:    _TicketService_ListBookings_Handler,
+		},
+		{
+			MethodName: "HoldSeat",
+			Handler:    _TicketService_HoldSeat_Handler,
+		},
+		{
+			MethodName: "ConfirmHold",
+			Handler:    _TicketService_ConfirmHold_Handler,
+		},
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _TicketService_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "LeaveWaitlist",
+			Handler:    _TicketService_LeaveWaitlist_Handler,
+		},
+		{
+			MethodName: "GetWaitlistPosition",
+			Handler:    _TicketService_GetWaitlistPosition_Handler,
+		},
+		{
+			MethodName: "CancelTicket",
+			Handler:    _TicketService_CancelTicket_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _TicketService_CheckIn_Handler,
+		},
+		{
+			MethodName: "Board",
+			Handler:    _TicketService_Board_Handler,
+		},
+		{
+			MethodName: "SwapSeats",
+			Handler:    _TicketService_SwapSeats_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _TicketService_Login_Handler,
+		},
+		{
+			MethodName: "GetSeatMap",
+			Handler:    _TicketService_GetSeatMap_Handler,
+		},
+		{
+			MethodName: "ListTrains",
+			Handler:    _TicketService_ListTrains_Handler,
+		},
+		{
+			MethodName: "SearchJourneys",
+			Handler:    _TicketService_SearchJourneys_Handler,
+		},
+		{
+			MethodName: "QuoteFare",
+			Handler:    _TicketService_QuoteFare_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSeatAvailability",
+			Handler:       _TicketService_WatchSeatAvailability_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/train.proto",
+}
+
+// TicketAdminServiceClient is the client API for TicketAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TicketAdminServiceClient interface {
+	RemovePassenger(ctx context.Context, in *RemovePassengerRequest, opts ...grpc.CallOption) (*RemovePassengerResponse, error)
+	BlockSeats(ctx context.Context, in *BlockSeatsRequest, opts ...grpc.CallOption) (*BlockSeatsResponse, error)
+	UnblockSeats(ctx context.Context, in *UnblockSeatsRequest, opts ...grpc.CallOption) (*UnblockSeatsResponse, error)
+	ListSeatBlocks(ctx context.Context, in *ListSeatBlocksRequest, opts ...grpc.CallOption) (*ListSeatBlocksResponse, error)
+	MoveSection(ctx context.Context, in *MoveSectionRequest, opts ...grpc.CallOption) (*MoveSectionResponse, error)
+	GetManifest(ctx context.Context, in *GetManifestRequest, opts ...grpc.CallOption) (*GetManifestResponse, error)
+	CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*CreatePromoCodeResponse, error)
+	DisablePromoCode(ctx context.Context, in *DisablePromoCodeRequest, opts ...grpc.CallOption) (*DisablePromoCodeResponse, error)
+}
+
+type ticketAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTicketAdminServiceClient(cc grpc.ClientConnInterface) TicketAdminServiceClient {
+	return &ticketAdminServiceClient{cc}
+}
+
+func (c *ticketAdminServiceClient) RemovePassenger(ctx context.Context, in *RemovePassengerRequest, opts ...grpc.CallOption) (*RemovePassengerResponse, error) {
+	out := new(RemovePassengerResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketAdminService/RemovePassenger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketAdminServiceClient) BlockSeats(ctx context.Context, in *BlockSeatsRequest, opts ...grpc.CallOption) (*BlockSeatsResponse, error) {
+	out := new(BlockSeatsResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketAdminService/BlockSeats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketAdminServiceClient) UnblockSeats(ctx context.Context, in *UnblockSeatsRequest, opts ...grpc.CallOption) (*UnblockSeatsResponse, error) {
+	out := new(UnblockSeatsResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketAdminService/UnblockSeats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketAdminServiceClient) ListSeatBlocks(ctx context.Context, in *ListSeatBlocksRequest, opts ...grpc.CallOption) (*ListSeatBlocksResponse, error) {
+	out := new(ListSeatBlocksResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketAdminService/ListSeatBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketAdminServiceClient) MoveSection(ctx context.Context, in *MoveSectionRequest, opts ...grpc.CallOption) (*MoveSectionResponse, error) {
+	out := new(MoveSectionResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketAdminService/MoveSection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketAdminServiceClient) GetManifest(ctx context.Context, in *GetManifestRequest, opts ...grpc.CallOption) (*GetManifestResponse, error) {
+	out := new(GetManifestResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketAdminService/GetManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketAdminServiceClient) CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*CreatePromoCodeResponse, error) {
+	out := new(CreatePromoCodeResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketAdminService/CreatePromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketAdminServiceClient) DisablePromoCode(ctx context.Context, in *DisablePromoCodeRequest, opts ...grpc.CallOption) (*DisablePromoCodeResponse, error) {
+	out := new(DisablePromoCodeResponse)
+	err := c.cc.Invoke(ctx, "/ticket_service.TicketAdminService/DisablePromoCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TicketAdminServiceServer is the server API for TicketAdminService service.
+// All implementations must embed UnimplementedTicketAdminServiceServer
+// for forward compatibility
+type TicketAdminServiceServer interface {
+	RemovePassenger(context.Context, *RemovePassengerRequest) (*RemovePassengerResponse, error)
+	BlockSeats(context.Context, *BlockSeatsRequest) (*BlockSeatsResponse, error)
+	UnblockSeats(context.Context, *UnblockSeatsRequest) (*UnblockSeatsResponse, error)
+	ListSeatBlocks(context.Context, *ListSeatBlocksRequest) (*ListSeatBlocksResponse, error)
+	MoveSection(context.Context, *MoveSectionRequest) (*MoveSectionResponse, error)
+	GetManifest(context.Context, *GetManifestRequest) (*GetManifestResponse, error)
+	CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*CreatePromoCodeResponse, error)
+	DisablePromoCode(context.Context, *DisablePromoCodeRequest) (*DisablePromoCodeResponse, error)
+	mustEmbedUnimplementedTicketAdminServiceServer()
+}
+
+// UnimplementedTicketAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTicketAdminServiceServer struct {
+}
+
+func (UnimplementedTicketAdminServiceServer) RemovePassenger(context.Context, *RemovePassengerRequest) (*RemovePassengerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePassenger not implemented")
+}
+func (UnimplementedTicketAdminServiceServer) BlockSeats(context.Context, *BlockSeatsRequest) (*BlockSeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockSeats not implemented")
+}
+func (UnimplementedTicketAdminServiceServer) UnblockSeats(context.Context, *UnblockSeatsRequest) (*UnblockSeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockSeats not implemented")
+}
+func (UnimplementedTicketAdminServiceServer) ListSeatBlocks(context.Context, *ListSeatBlocksRequest) (*ListSeatBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeatBlocks not implemented")
+}
+func (UnimplementedTicketAdminServiceServer) MoveSection(context.Context, *MoveSectionRequest) (*MoveSectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveSection not implemented")
+}
+func (UnimplementedTicketAdminServiceServer) GetManifest(context.Context, *GetManifestRequest) (*GetManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManifest not implemented")
+}
+func (UnimplementedTicketAdminServiceServer) CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*CreatePromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromoCode not implemented")
+}
+func (UnimplementedTicketAdminServiceServer) DisablePromoCode(context.Context, *DisablePromoCodeRequest) (*DisablePromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisablePromoCode not implemented")
+}
+func (UnimplementedTicketAdminServiceServer) mustEmbedUnimplementedTicketAdminServiceServer() {}
+
+// UnsafeTicketAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TicketAdminServiceServer will
+// result in compilation errors.
+type UnsafeTicketAdminServiceServer interface {
+	mustEmbedUnimplementedTicketAdminServiceServer()
+}
+
+func RegisterTicketAdminServiceServer(s grpc.ServiceRegistrar, srv TicketAdminServiceServer) {
+	s.RegisterService(&TicketAdminService_ServiceDesc, srv)
+}
+
+func _TicketAdminService_RemovePassenger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePassengerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketAdminServiceServer).RemovePassenger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketAdminService/RemovePassenger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketAdminServiceServer).RemovePassenger(ctx, req.(*RemovePassengerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketAdminService_BlockSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketAdminServiceServer).BlockSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketAdminService/BlockSeats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketAdminServiceServer).BlockSeats(ctx, req.(*BlockSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketAdminService_UnblockSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketAdminServiceServer).UnblockSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketAdminService/UnblockSeats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketAdminServiceServer).UnblockSeats(ctx, req.(*UnblockSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketAdminService_ListSeatBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeatBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketAdminServiceServer).ListSeatBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketAdminService/ListSeatBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketAdminServiceServer).ListSeatBlocks(ctx, req.(*ListSeatBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketAdminService_MoveSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketAdminServiceServer).MoveSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketAdminService/MoveSection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketAdminServiceServer).MoveSection(ctx, req.(*MoveSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketAdminService_GetManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketAdminServiceServer).GetManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketAdminService/GetManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketAdminServiceServer).GetManifest(ctx, req.(*GetManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketAdminService_CreatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketAdminServiceServer).CreatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketAdminService/CreatePromoCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketAdminServiceServer).CreatePromoCode(ctx, req.(*CreatePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketAdminService_DisablePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisablePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketAdminServiceServer).DisablePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ticket_service.TicketAdminService/DisablePromoCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketAdminServiceServer).DisablePromoCode(ctx, req.(*DisablePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TicketAdminService_ServiceDesc is the grpc.ServiceDesc for TicketAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TicketAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ticket_service.TicketAdminService",
+	HandlerType: (*TicketAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RemovePassenger",
+			Handler:    _TicketAdminService_RemovePassenger_Handler,
+		},
+		{
+			MethodName: "BlockSeats",
+			Handler:    _TicketAdminService_BlockSeats_Handler,
+		},
+		{
+			MethodName: "UnblockSeats",
+			Handler:    _TicketAdminService_UnblockSeats_Handler,
+		},
+		{
+			MethodName: "ListSeatBlocks",
+			Handler:    _TicketAdminService_ListSeatBlocks_Handler,
+		},
+		{
+			MethodName: "MoveSection",
+			Handler:    _TicketAdminService_MoveSection_Handler,
+		},
+		{
+			MethodName: "GetManifest",
+			Handler:    _TicketAdminService_GetManifest_Handler,
+		},
+		{
+			MethodName: "CreatePromoCode",
+			Handler:    _TicketAdminService_CreatePromoCode_Handler,
+		},
+		{
+			MethodName: "DisablePromoCode",
+			Handler:    _TicketAdminService_DisablePromoCode_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// AdminServer serves TicketAdminService, the operator calls. It shares the
+// booking state and lock of the Server selling tickets; the auth
+// interceptor lets only admins call it.
+type AdminServer struct {
+	*Server
+	pb.UnimplementedTicketAdminServiceServer
+}
+
+// Statuses an operator can remove a passenger from
+var removableStatuses = map[pb.TicketStatus]bool{
+	pb.TicketStatus_TICKET_STATUS_PURCHASED:  true,
+	pb.TicketStatus_TICKET_STATUS_SEATED:     true,
+	pb.TicketStatus_TICKET_STATUS_CHECKED_IN: true,
+	pb.TicketStatus_TICKET_STATUS_BOARDED:    true,
+}
+
+func (s *AdminServer) RemovePassenger(ctx context.Context, req *pb.RemovePassengerRequest) (*pb.RemovePassengerResponse, error) {
+	// Validate the request
+	if req == nil || req.PurchaseId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Purchase id cannot be empty")
+	}
+	reason := req.Reason
+	if reason == "" {
+		reason = "Removed by an operator"
+	}
+
+	s.mu.Lock()
+	receipt, err := s.lookupTicket(req.PurchaseId, "")
+	if err == nil && !removableStatuses[receipt.Status] {
+		err = status.Errorf(codes.FailedPrecondition, "Cannot remove passenger: purchase %s is %s", receipt.PurchaseId, statusNames[receipt.Status])
+	}
+
+	// Unlike CancelTicket, this works after check-in and departure
+	if err == nil {
+		s.audit(receipt, "passenger_removed", adminActor, reason)
+		err = s.withdrawTicket(receipt, reason, req.WaiveFee)
+	}
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("admin: removed purchase %s (%s)", receipt.PurchaseId, reason)
+
+	// Refund what is due through the payment provider
+	receipt, err = s.issueRefund(ctx, receipt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RemovePassengerResponse{Receipt: receipt}, nil
+}
+
+// Helper function to check the train, date and section seats are blocked
+// or unblocked on. The date may be empty, meaning every date.
+func (s *AdminServer) blockSection(trainID, date, sectionName string, seatNumbers []int32) (*SectionLayout, error) {
+	if trainID == "" || sectionName == "" || len(seatNumbers) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Train id, section and seat numbers cannot be empty")
+	}
+	train := s.catalog.Train(trainID)
+	if train == nil {
+		return nil, status.Errorf(codes.NotFound, "Unknown train: %s", trainID)
+	}
+	if date != "" {
+		if _, _, err := s.resolveDeparture(trainID, date); err != nil {
+			return nil, err
+		}
+	}
+	section := train.Section(sectionName)
+	if section == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid section: %s (available: %s)", sectionName, train.SectionNames())
+	}
+	for _, seatNumber := range seatNumbers {
+		if !section.HasSeat(seatNumber) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid seat number: %d (section %s has %d seats)", seatNumber, section.Name, len(section.Seats()))
+		}
+	}
+	return section, nil
+}
+
+func (s *AdminServer) BlockSeats(ctx context.Context, req *pb.BlockSeatsRequest) (*pb.BlockSeatsResponse, error) {
+	// Validate the request
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+	section, err := s.blockSection(req.TrainId, req.Date, req.Section, req.SeatNumbers)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Passengers already in a blocked seat keep it; the block only stops the
+	// seat being given to anyone else
+	blockedAt := s.now().Format(time.RFC3339)
+	blocks := make([]*pb.SeatBlock, 0, len(req.SeatNumbers))
+	for _, seatNumber := range req.SeatNumbers {
+		block := &pb.SeatBlock{
+			TrainId:    req.TrainId,
+			Date:       req.Date,
+			Section:    section.Name,
+			SeatNumber: seatNumber,
+			Reason:     req.Reason,
+			BlockedAt:  blockedAt,
+		}
+		if err := s.blocks.PutSeatBlock(block); err != nil {
+			return nil, storeError(err)
+		}
+		blocks = append(blocks, block)
+	}
+	log.Printf("admin: blocked %d seats in section %s of train %s (%s)", len(blocks), section.Name, req.TrainId, req.Reason)
+
+	return &pb.BlockSeatsResponse{Blocks: blocks}, nil
+}
+
+func (s *AdminServer) UnblockSeats(ctx context.Context, req *pb.UnblockSeatsRequest) (*pb.UnblockSeatsResponse, error) {
+	// Validate the request
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+	section, err := s.blockSection(req.TrainId, req.Date, req.Section, req.SeatNumbers)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var unblocked int32
+	for _, block := range s.blocks.ListSeatBlocks(req.TrainId) {
+		if block.Date != req.Date || block.Section != section.Name || !slices.Contains(req.SeatNumbers, block.SeatNumber) {
+			continue
+		}
+		if err := s.blocks.DeleteSeatBlock(block); err != nil {
+			return nil, storeError(err)
+		}
+		unblocked++
+	}
+
+	// Offer the seats back to the waitlist
+	if unblocked > 0 && req.Date != "" {
+		s.serveWaitlist(Departure{TrainID: req.TrainId, Date: req.Date})
+	}
+
+	return &pb.UnblockSeatsResponse{Unblocked: unblocked}, nil
+}
+
+func (s *AdminServer) ListSeatBlocks(ctx context.Context, req *pb.ListSeatBlocksRequest) (*pb.ListSeatBlocksResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return &pb.ListSeatBlocksResponse{Blocks: s.blocks.ListSeatBlocks(req.GetTrainId())}, nil
+}
+
+func (s *AdminServer) MoveSection(ctx context.Context, req *pb.MoveSectionRequest) (*pb.MoveSectionResponse, error) {
+	// Validate the request
+	if req == nil || req.FromSection == "" || req.ToSection == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Both sections must be given")
+	}
+	if req.FromSection == req.ToSection {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Cannot move a section to itself")
+	}
+	train, day, err := s.resolveDeparture(req.TrainId, req.Date)
+	if err != nil {
+		return nil, err
+	}
+	from := train.Section(req.FromSection)
+	if from == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid section: %s (available: %s)", req.FromSection, train.SectionNames())
+	}
+	to := train.Section(req.ToSection)
+	if to == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid section: %s (available: %s)", req.ToSection, train.SectionNames())
+	}
+	departure := Departure{TrainID: train.ID, Date: day.Format(dateLayout)}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Plan every move before making any, so the section moves as a whole
+	var tickets, previous []*pb.Receipt
+	var moves []*pb.SeatMove
+	planned := make(map[int32][]Leg)
+	free := func(seatNumber int32, leg Leg) bool {
+		for _, other := range planned[seatNumber] {
+			if other.Overlaps(leg) {
+				return false
+			}
+		}
+		return s.seatFree(train, departure, to.Name, seatNumber, leg)
+	}
+	for _, ticket := range s.refreshStatuses(s.store.ListBySection(departure, from.Name)) {
+		if !isActive(ticket.Status) {
+			continue
+		}
+		if err := checkSeatClass(ticket, to); err != nil {
+			return nil, err
+		}
+
+		leg := receiptLeg(train, ticket)
+		seatNumber := ticket.Seat.SeatNumber
+		if !to.HasSeat(seatNumber) || !free(seatNumber, leg) {
+			seatNumber = -1
+			for _, seat := range to.Seats() {
+				if free(seat.Number, leg) {
+					seatNumber = seat.Number
+					break
+				}
+			}
+		}
+		if seatNumber < 0 {
+			return nil, status.Errorf(codes.ResourceExhausted, "Section %s does not have room for every passenger in section %s", to.Name, from.Name)
+		}
+		planned[seatNumber] = append(planned[seatNumber], leg)
+
+		previous = append(previous, proto.Clone(ticket).(*pb.Receipt))
+		move := &pb.SeatMove{
+			PurchaseId: ticket.PurchaseId,
+			From:       ticket.Seat,
+			To:         &pb.Seat{Section: to.Name, SeatNumber: seatNumber},
+		}
+		ticket.Seat = move.To
+		s.audit(ticket, "section_moved", adminActor, fmt.Sprintf("Moved from seat %s to %s", seatLabel(move.From), seatLabel(move.To)))
+		tickets = append(tickets, ticket)
+		moves = append(moves, move)
+	}
+
+	if err := s.dropSwapRequests(tickets...); err != nil {
+		return nil, storeError(err)
+	}
+	if err := s.putTickets(tickets, previous); err != nil {
+		return nil, storeError(err)
+	}
+	for i, ticket := range tickets {
+		s.emitSeat(eventSeatReleased, previous[i])
+		s.emitSeat(eventSeatAllocated, ticket)
+	}
+	log.Printf("admin: moved %d passengers on %s from section %s to %s", len(moves), departure, from.Name, to.Name)
+
+	// Offer the seats left behind to the waitlist, unless they are blocked
+	s.serveWaitlist(departure)
+
+	return &pb.MoveSectionResponse{Moves: moves}, nil
+}
+
+func (s *AdminServer) GetManifest(ctx context.Context, req *pb.GetManifestRequest) (*pb.GetManifestResponse, error) {
+	// Validate the request
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+	train, day, err := s.resolveDeparture(req.TrainId, req.Date)
+	if err != nil {
+		return nil, err
+	}
+	departure := Departure{TrainID: train.ID, Date: day.Format(dateLayout)}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	getManifestResponse := &pb.GetManifestResponse{
+		TrainId:      departure.TrainID,
+		Date:         departure.Date,
+		Tickets:      s.refreshStatuses(s.store.ListByDeparture(departure)),
+		StatusCounts: make(map[string]int32),
+	}
+	for _, ticket := range getManifestResponse.Tickets {
+		getManifestResponse.StatusCounts[strings.TrimPrefix(ticket.Status.String(), "TICKET_STATUS_")]++
+	}
+	for _, block := range s.blocks.ListSeatBlocks(train.ID) {
+		if block.Date == "" || block.Date == departure.Date {
+			getManifestResponse.Blocks = append(getManifestResponse.Blocks, block)
+		}
+	}
+
+	return getManifestResponse, nil
+}
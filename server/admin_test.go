@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Helper function to get an incoming call context carrying token
+func tokenContext(token string) context.Context {
+	if token == "" {
+		return context.Background()
+	}
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, "Bearer "+token))
+}
+
+func TestAdminServiceNeedsAnAdmin(t *testing.T) {
+	s := newTestServer(t)
+	now := s.now()
+	adminToken, _ := s.auth.issue(roleAdmin, roleAdmin, now)
+	passengerToken, _ := s.auth.issue("passenger@example.com", rolePassenger, now)
+	expiredToken, _ := s.auth.issue(roleAdmin, roleAdmin, now.Add(-2*defaultTokenTTL))
+
+	tests := []struct {
+		caller   string
+		token    string
+		wantCode codes.Code
+	}{
+		{"nobody", "", codes.Unauthenticated},
+		{"a forged token", adminToken + "x", codes.Unauthenticated},
+		{"an expired admin", expiredToken, codes.Unauthenticated},
+		{"a passenger", passengerToken, codes.PermissionDenied},
+		{"an admin", adminToken, codes.OK},
+	}
+	for _, method := range pb.TicketAdminService_ServiceDesc.Methods {
+		fullMethod := "/" + pb.TicketAdminService_ServiceDesc.ServiceName + "/" + method.MethodName
+		for _, tt := range tests {
+			_, err := s.authenticate(tokenContext(tt.token), fullMethod)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("%s called by %s: got %v, want %v", method.MethodName, tt.caller, err, tt.wantCode)
+			}
+		}
+	}
+}
+
+func TestAdminRequestValidation(t *testing.T) {
+	s := newTestServer(t)
+	admin := &AdminServer{Server: s}
+	ctx := adminContext()
+
+	tests := []struct {
+		name     string
+		call     func() error
+		wantCode codes.Code
+	}{
+		{"remove without a purchase id", func() error {
+			_, err := admin.RemovePassenger(ctx, &pb.RemovePassengerRequest{})
+			return err
+		}, codes.InvalidArgument},
+		{"remove an unknown purchase", func() error {
+			_, err := admin.RemovePassenger(ctx, &pb.RemovePassengerRequest{PurchaseId: "missing"})
+			return err
+		}, codes.NotFound},
+		{"block without seats", func() error {
+			_, err := admin.BlockSeats(ctx, &pb.BlockSeatsRequest{TrainId: "T1", Section: "A"})
+			return err
+		}, codes.InvalidArgument},
+		{"block on an unknown train", func() error {
+			_, err := admin.BlockSeats(ctx, &pb.BlockSeatsRequest{TrainId: "T9", Section: "A", SeatNumbers: []int32{1}})
+			return err
+		}, codes.NotFound},
+		{"block a seat that does not exist", func() error {
+			_, err := admin.BlockSeats(ctx, &pb.BlockSeatsRequest{TrainId: "T1", Section: "A", SeatNumbers: []int32{11}})
+			return err
+		}, codes.InvalidArgument},
+		{"unblock in an unknown section", func() error {
+			_, err := admin.UnblockSeats(ctx, &pb.UnblockSeatsRequest{TrainId: "T1", Section: "Z", SeatNumbers: []int32{1}})
+			return err
+		}, codes.InvalidArgument},
+		{"move a section to itself", func() error {
+			_, err := admin.MoveSection(ctx, &pb.MoveSectionRequest{FromSection: "A", ToSection: "A"})
+			return err
+		}, codes.InvalidArgument},
+		{"move to an unknown section", func() error {
+			_, err := admin.MoveSection(ctx, &pb.MoveSectionRequest{FromSection: "A", ToSection: "Z"})
+			return err
+		}, codes.InvalidArgument},
+		{"manifest of a bad date", func() error {
+			_, err := admin.GetManifest(ctx, &pb.GetManifestRequest{Date: "June"})
+			return err
+		}, codes.InvalidArgument},
+		{"manifest of an unknown train", func() error {
+			_, err := admin.GetManifest(ctx, &pb.GetManifestRequest{TrainId: "T9"})
+			return err
+		}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		if err := tt.call(); status.Code(err) != tt.wantCode {
+			t.Errorf("%s: got %v, want %v", tt.name, err, tt.wantCode)
+		}
+	}
+}
+
+func TestMoveSectionAndManifest(t *testing.T) {
+	s := newTestServer(t)
+	clock := &fakeClock{now: time.Now()}
+	s.now = clock.Now
+	admin := &AdminServer{Server: s}
+	ctx := adminContext()
+
+	var moved []string
+	for _, email := range []string{"a1@example.com", "a2@example.com", "a3@example.com"} {
+		moved = append(moved, seatedInA(t, s, email, "London", "Paris"))
+	}
+	stayed := purchaseTicket(t, s, "b1@example.com", "London", "Paris").PurchaseId
+	if _, err := s.AllocateSeat(ctx, &pb.AllocateSeatRequest{PurchaseId: stayed, Section: "B"}); err != nil {
+		t.Fatalf("AllocateSeat: %v", err)
+	}
+	purchaseTicket(t, s, "unseated@example.com", "London", "Paris")
+
+	response, err := admin.MoveSection(ctx, &pb.MoveSectionRequest{FromSection: "A", ToSection: "B"})
+	if err != nil {
+		t.Fatalf("MoveSection: %v", err)
+	}
+	if len(response.Moves) != len(moved) {
+		t.Fatalf("got %d moves, want %d", len(response.Moves), len(moved))
+	}
+
+	// Passengers keep their seat number unless it is taken in the new section
+	tests := []struct {
+		purchaseID string
+		wantSeat   string
+	}{
+		{moved[0], "B-2"},
+		{moved[1], "B-3"},
+		{moved[2], "B-4"},
+		{stayed, "B-1"},
+	}
+	for _, tt := range tests {
+		if seat := ticketSeat(t, s, tt.purchaseID); seat != tt.wantSeat {
+			t.Errorf("%s is in seat %q, want %q", tt.purchaseID, seat, tt.wantSeat)
+		}
+	}
+	checkNoDoubleBooking(t, s)
+
+	if _, err := admin.RemovePassenger(ctx, &pb.RemovePassengerRequest{PurchaseId: stayed, WaiveFee: true}); err != nil {
+		t.Fatalf("RemovePassenger: %v", err)
+	}
+	manifest, err := admin.GetManifest(ctx, &pb.GetManifestRequest{})
+	if err != nil {
+		t.Fatalf("GetManifest: %v", err)
+	}
+	if len(manifest.Tickets) != 5 {
+		t.Fatalf("manifest lists %d tickets, want 5", len(manifest.Tickets))
+	}
+	for name, want := range map[string]int32{"SEATED": 3, "PURCHASED": 1, "REFUNDED": 1} {
+		if got := manifest.StatusCounts[name]; got != want {
+			t.Errorf("manifest counts %d %s tickets, want %d", got, name, want)
+		}
+	}
+}
@@ -0,0 +1,344 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata entry carrying the bearer token
+const authorizationHeader = "authorization"
+
+// How long a token from Login is accepted unless configured otherwise
+const defaultTokenTTL = 12 * time.Hour
+
+// Roles a token can grant
+const (
+	rolePassenger = "passenger"
+	roleAdmin     = "admin"
+)
+
+// Who may call a method
+type access int
+
+const (
+	// Anyone, signed in or not
+	accessPublic access = iota
+	// Signed-in passengers, for their own tickets, and admins
+	accessPassenger
+	// Admins only
+	accessAdmin
+)
+
+// What each method needs. Methods not listed are for admins only, so a new
+// method is closed until it is added here.
+var methodAccess = map[string]access{
+	"/ticket_service.TicketService/Login":                 accessPublic,
+	"/ticket_service.TicketService/PurchaseTicket":        accessPublic,
+	"/ticket_service.TicketService/ListTrains":            accessPublic,
+	"/ticket_service.TicketService/SearchJourneys":        accessPublic,
+	"/ticket_service.TicketService/QuoteFare":             accessPublic,
+	"/ticket_service.TicketService/GetSeatMap":            accessPublic,
+	"/ticket_service.TicketService/WatchSeatAvailability": accessPublic,
+	"/ticket_service.TicketService/AllocateSeat":          accessPassenger,
+	"/ticket_service.TicketService/ShowReceipt":           accessPassenger,
+	"/ticket_service.TicketService/ModifySeat":            accessPassenger,
+	"/ticket_service.TicketService/ListBookings":          accessPassenger,
+	"/ticket_service.TicketService/HoldSeat":              accessPassenger,
+	"/ticket_service.TicketService/ConfirmHold":           accessPassenger,
+	"/ticket_service.TicketService/JoinWaitlist":          accessPassenger,
+	"/ticket_service.TicketService/LeaveWaitlist":         accessPassenger,
+	"/ticket_service.TicketService/GetWaitlistPosition":   accessPassenger,
+	"/ticket_service.TicketService/CancelTicket":          accessPassenger,
+	"/ticket_service.TicketService/CheckIn":               accessPassenger,
+	"/ticket_service.TicketService/Board":                 accessPassenger,
+	"/ticket_service.TicketService/SwapSeats":             accessPassenger,
+
+	// Load balancers and tools such as grpcurl probe and explore the server
+	// without signing in
+	"/grpc.health.v1.Health/Check":                                   accessPublic,
+	"/grpc.health.v1.Health/Watch":                                   accessPublic,
+	"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo":      accessPublic,
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": accessPublic,
+}
+
+// claims is what a token says about its holder.
+type claims struct {
+	Subject  string `json:"sub"`
+	Role     string `json:"role"`
+	IssuedAt int64  `json:"iat"`
+	Expires  int64  `json:"exp"`
+}
+
+// authenticator issues and checks bearer tokens: JSON Web Tokens signed
+// with HMAC-SHA256 under a secret only the server knows.
+type authenticator struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// The header of every token the server issues
+var tokenHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+var errInvalidToken = errors.New("invalid token")
+
+func newAuthenticator(secret []byte, ttl time.Duration) *authenticator {
+	return &authenticator{secret: secret, ttl: ttl}
+}
+
+// Helper function to make a secret for a server started without one.
+// Tokens signed with it stop working when the server restarts.
+func randomSecret() []byte {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		panic(err)
+	}
+	return secret
+}
+
+// Helper function to make the secret a customer signs in with after buying a
+// booking. Only its hash is kept, on the booking's tickets.
+func newBookingSecret() (secret, hash string) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		panic(err)
+	}
+	secret = base64.RawURLEncoding.EncodeToString(random)
+	return secret, bookingSecretHash(secret)
+}
+
+// Helper function to hash a booking secret the way it is kept on tickets
+func bookingSecretHash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// Helper function to sign the header and payload of a token
+func (a *authenticator) sign(unsigned string) string {
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write([]byte(unsigned))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// issue returns a token for subject in role and when it expires.
+func (a *authenticator) issue(subject, role string, now time.Time) (string, time.Time) {
+	expires := now.Add(a.ttl)
+	payload, err := json.Marshal(claims{
+		Subject:  subject,
+		Role:     role,
+		IssuedAt: now.Unix(),
+		Expires:  expires.Unix(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	unsigned := tokenHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + a.sign(unsigned), expires
+}
+
+// verify checks the signature and expiry of a token and returns its claims.
+func (a *authenticator) verify(token string, now time.Time) (*claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != tokenHeader {
+		return nil, errInvalidToken
+	}
+	if !hmac.Equal([]byte(parts[2]), []byte(a.sign(parts[0]+"."+parts[1]))) {
+		return nil, errInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errInvalidToken
+	}
+	var c claims
+	if err := json.Unmarshal(payload, &c); err != nil || c.Subject == "" {
+		return nil, errInvalidToken
+	}
+	if now.Unix() >= c.Expires {
+		return nil, errors.New("token expired")
+	}
+	return &c, nil
+}
+
+type claimsKey struct{}
+
+// Helper function to get the claims of the caller, or nil if they did not
+// sign in
+func claimsFrom(ctx context.Context) *claims {
+	c, _ := ctx.Value(claimsKey{}).(*claims)
+	return c
+}
+
+// authenticate checks the bearer token of a call against what the method
+// needs and returns the context with the caller's claims attached. A token
+// is optional for public methods but must be valid if sent.
+func (s *Server) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if s.auth == nil {
+		return ctx, nil
+	}
+
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authorizationHeader); len(values) > 0 {
+			token = strings.TrimPrefix(values[0], "Bearer ")
+		}
+	}
+
+	need, listed := methodAccess[method]
+	if !listed {
+		need = accessAdmin
+	}
+	if token == "" {
+		if need == accessPublic {
+			return ctx, nil
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "Sign in with Login and send the token as authorization metadata")
+	}
+
+	c, err := s.auth.verify(token, s.now())
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid access token: %v", err)
+	}
+	if need == accessAdmin && c.Role != roleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "Only admins may call %s", method[strings.LastIndex(method, "/")+1:])
+	}
+	return context.WithValue(ctx, claimsKey{}, c), nil
+}
+
+func (s *Server) authInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := s.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// authenticatedStream is a server stream carrying the caller's claims.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (a *authenticatedStream) Context() context.Context {
+	return a.ctx
+}
+
+func (s *Server) authStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := s.authenticate(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+}
+
+// Helper function to check whether a call was made by an admin
+func (s *Server) isAdmin(ctx context.Context) bool {
+	c := claimsFrom(ctx)
+	return c != nil && c.Role == roleAdmin
+}
+
+// Helper function to check that the caller may act for the holder of an
+// email: it is their own, or they are an admin
+func (s *Server) authorizeEmail(ctx context.Context, email string) error {
+	if s.auth == nil {
+		return nil
+	}
+	c := claimsFrom(ctx)
+	if c == nil {
+		return status.Errorf(codes.Unauthenticated, "Sign in with Login and send the token as authorization metadata")
+	}
+	if c.Role == roleAdmin || c.Subject == email {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "Signed in as %s, not %s", c.Subject, email)
+}
+
+// Helper function to check that the caller may act on a ticket: they
+// travel on it or booked it, or they are an admin
+func (s *Server) authorizeTicket(ctx context.Context, receipt *pb.Receipt) error {
+	if s.auth == nil {
+		return nil
+	}
+	c := claimsFrom(ctx)
+	if c == nil {
+		return status.Errorf(codes.Unauthenticated, "Sign in with Login and send the token as authorization metadata")
+	}
+	if c.Role == roleAdmin || receipt.GetUser().GetEmail() == c.Subject || receipt.BookedBy == c.Subject {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "Purchase %s belongs to another passenger", receipt.PurchaseId)
+}
+
+// Helper function to find a ticket the caller may act on.
+// Callers must hold s.mu.
+func (s *Server) lookupOwnTicket(ctx context.Context, purchaseID, email string) (*pb.Receipt, error) {
+	receipt, err := s.lookupTicket(purchaseID, email)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeTicket(ctx, receipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// Helper function to check whether a customer with an email has booked any
+// ticket, and so can sign in with Login. Being a passenger on someone else's
+// booking does not count. Callers must hold s.mu.
+func (s *Server) emailHasBookings(email string) bool {
+	return len(s.store.ListBookedBy(email)) > 0
+}
+
+func (s *Server) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	if s.auth == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Authentication is not enabled on this server")
+	}
+
+	// Admins sign in with the admin secret
+	if req.GetAdminSecret() != "" {
+		if s.adminSecret == "" || subtle.ConstantTimeCompare([]byte(req.AdminSecret), []byte(s.adminSecret)) != 1 {
+			return nil, status.Errorf(codes.Unauthenticated, "Invalid admin secret")
+		}
+		token, expires := s.auth.issue(roleAdmin, roleAdmin, s.now())
+		return &pb.LoginResponse{AccessToken: token, ExpiresAt: expires.Format(time.RFC3339), Role: roleAdmin}, nil
+	}
+
+	// Validate the request
+	if req == nil || req.Email == "" || req.PurchaseId == "" || req.BookingSecret == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Email, purchase id and booking secret cannot be empty")
+	}
+
+	s.mu.RLock()
+	receipt, exists := s.store.GetReceipt(req.PurchaseId)
+	s.mu.RUnlock()
+
+	// Customers prove who they are with the secret they were given when they
+	// booked. A purchase id alone proves nothing: it is shown to others, and
+	// anyone can buy a ticket in any name.
+	if !exists || receipt.BookedBy != req.Email || receipt.BookingSecretHash == "" ||
+		subtle.ConstantTimeCompare([]byte(receipt.BookingSecretHash), []byte(bookingSecretHash(req.BookingSecret))) != 1 {
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid email, purchase id or booking secret")
+	}
+
+	token, expires := s.auth.issue(req.Email, rolePassenger, s.now())
+	loginResponse := &pb.LoginResponse{
+		AccessToken: token,
+		ExpiresAt:   expires.Format(time.RFC3339),
+		Role:        rolePassenger,
+	}
+
+	return loginResponse, nil
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoginNeedsTheBookingSecret(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	response := purchaseTicket(t, s, "jo@example.com", "London", "Paris")
+	if response.AccessToken != "" {
+		t.Fatalf("anonymous purchase returned an access token")
+	}
+	if response.BookingSecret == "" {
+		t.Fatalf("purchase returned no booking secret")
+	}
+
+	// The purchase id is shown to others, so it is not enough on its own
+	_, err := s.Login(ctx, &pb.LoginRequest{Email: "jo@example.com", PurchaseId: response.PurchaseId})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Login without the booking secret: got %v, want InvalidArgument", err)
+	}
+	_, err = s.Login(ctx, &pb.LoginRequest{Email: "jo@example.com", PurchaseId: response.PurchaseId, BookingSecret: "guess"})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("Login with a wrong booking secret: got %v, want Unauthenticated", err)
+	}
+
+	login, err := s.Login(ctx, &pb.LoginRequest{Email: "jo@example.com", PurchaseId: response.PurchaseId, BookingSecret: response.BookingSecret})
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	c, err := s.auth.verify(login.AccessToken, s.now())
+	if err != nil || c.Subject != "jo@example.com" {
+		t.Fatalf("Login token: claims %+v, error %v", c, err)
+	}
+}
+
+func TestStrangerCannotSignInAsAnotherCustomer(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	victim := purchaseTicket(t, s, "victim@example.com", "London", "Paris")
+
+	// Buying in the name of an email that has tickets needs its owner to
+	// sign in, so a stranger gets no booking secret for it
+	_, err := s.PurchaseTicket(ctx, &pb.PurchaseRequest{
+		From: "London", To: "Paris",
+		User: &pb.User{FirstName: "Not", LastName: "Victim", Email: "victim@example.com"},
+	})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("anonymous purchase under an email with tickets: got %v, want Unauthenticated", err)
+	}
+
+	// A booking secret only signs in the customer who made that booking
+	other := purchaseTicket(t, s, "attacker@example.com", "London", "Paris")
+	_, err = s.Login(ctx, &pb.LoginRequest{Email: "victim@example.com", PurchaseId: victim.PurchaseId, BookingSecret: other.BookingSecret})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("Login with another booking's secret: got %v, want Unauthenticated", err)
+	}
+
+	// including when the stranger booked a ticket for the victim
+	group, err := s.PurchaseTicket(ctx, &pb.PurchaseRequest{
+		From: "London", To: "Paris",
+		User: &pb.User{FirstName: "Lead", LastName: "Booker", Email: "lead@example.com"},
+		Passengers: []*pb.User{
+			{FirstName: "Lead", LastName: "Booker"},
+			{FirstName: "Friend", LastName: "Passenger", Email: "victim@example.com"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket for a group: %v", err)
+	}
+	_, err = s.Login(ctx, &pb.LoginRequest{Email: "victim@example.com", PurchaseId: group.Tickets[1].PurchaseId, BookingSecret: group.BookingSecret})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("Login as a passenger with the booker's secret: got %v, want Unauthenticated", err)
+	}
+}
+
+func TestPurchaseRenewsTokenOfSignedInBuyer(t *testing.T) {
+	s := newTestServer(t)
+
+	response, err := s.PurchaseTicket(passengerContext("jo@example.com"), &pb.PurchaseRequest{
+		From: "London", To: "Paris",
+		User: &pb.User{FirstName: "Jo", LastName: "Doe", Email: "jo@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket: %v", err)
+	}
+	c, err := s.auth.verify(response.AccessToken, s.now())
+	if err != nil || c.Subject != "jo@example.com" || c.Role != rolePassenger {
+		t.Fatalf("renewed token: claims %+v, error %v", c, err)
+	}
+
+	// Signing in does not allow buying in someone else's name
+	_, err = s.PurchaseTicket(passengerContext("jo@example.com"), &pb.PurchaseRequest{
+		From: "London", To: "Paris",
+		User: &pb.User{FirstName: "Sam", LastName: "Doe", Email: "sam@example.com"},
+	})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("PurchaseTicket in another name: got %v, want PermissionDenied", err)
+	}
+}
+
+func TestGroupPassengerCanBookAndSignIn(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	// Being listed on someone else's booking does not lock a passenger out
+	_, err := s.PurchaseTicket(ctx, &pb.PurchaseRequest{
+		From: "London", To: "Paris",
+		User: &pb.User{FirstName: "Lead", LastName: "Booker", Email: "lead@example.com"},
+		Passengers: []*pb.User{
+			{FirstName: "Lead", LastName: "Booker"},
+			{FirstName: "Friend", LastName: "Passenger", Email: "friend@example.com"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket for a group: %v", err)
+	}
+
+	own := purchaseTicket(t, s, "friend@example.com", "Paris", "Brussels")
+	login, err := s.Login(ctx, &pb.LoginRequest{Email: "friend@example.com", PurchaseId: own.PurchaseId, BookingSecret: own.BookingSecret})
+	if err != nil {
+		t.Fatalf("Login as the group passenger: %v", err)
+	}
+	if login.Role != rolePassenger {
+		t.Fatalf("Login as the group passenger: role %s, want %s", login.Role, rolePassenger)
+	}
+}
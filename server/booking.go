@@ -0,0 +1,93 @@
+package main
+
+import (
+	"log"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Most passengers a single booking can hold
+const maxPassengers = 9
+
+// Helper function to list the passengers of a purchase. Without passengers
+// the purchaser travels alone; a passenger without an email is reached
+// through the purchaser's.
+func bookingPassengers(req *pb.PurchaseRequest) ([]*pb.User, error) {
+	if len(req.Passengers) == 0 {
+		return []*pb.User{req.User}, nil
+	}
+	if len(req.Passengers) > maxPassengers {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: A booking can hold at most %d passengers", maxPassengers)
+	}
+
+	passengers := make([]*pb.User, 0, len(req.Passengers))
+	for i, passenger := range req.Passengers {
+		if passenger == nil || passenger.FirstName == "" || passenger.LastName == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid request: First name and last name of passenger %d cannot be empty", i+1)
+		}
+		passenger = proto.Clone(passenger).(*pb.User)
+		if passenger.Email == "" {
+			passenger.Email = req.User.Email
+		}
+		passengers = append(passengers, passenger)
+	}
+	return passengers, nil
+}
+
+// Helper function to find the ticket a request refers to, with its status
+// brought up to date. Tickets are identified by purchase ID; requests from
+// older clients identify them by email instead, which only works while the
+// email holds a single active ticket. Callers must hold s.mu.
+func (s *Server) lookupTicket(purchaseID, email string) (*pb.Receipt, error) {
+	if purchaseID != "" {
+		receipt, exists := s.store.GetReceipt(purchaseID)
+		if !exists {
+			return nil, status.Errorf(codes.NotFound, "Purchase not found: %s", purchaseID)
+		}
+		s.refreshStatus(receipt)
+		return receipt, nil
+	}
+
+	var receipts []*pb.Receipt
+	for _, receipt := range s.refreshStatuses(s.store.ListByEmail(email)) {
+		if isActive(receipt.Status) {
+			receipts = append(receipts, receipt)
+		}
+	}
+	switch len(receipts) {
+	case 0:
+		return nil, status.Errorf(codes.NotFound, "Purchase not found for the provided email")
+	case 1:
+		return receipts[0], nil
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "%d tickets are booked under %s; specify the purchase id", len(receipts), email)
+	}
+}
+
+// Helper function to store the tickets of a booking. If one cannot be
+// stored, the tickets already written are put back the way they were (or
+// removed if they are new) so the booking is never left half changed.
+// previous holds the stored version of each ticket, or nil. Callers must
+// hold s.mu for writing.
+func (s *Server) putTickets(tickets, previous []*pb.Receipt) error {
+	for i, ticket := range tickets {
+		if err := s.store.PutReceipt(ticket); err != nil {
+			for j := i - 1; j >= 0; j-- {
+				var undoErr error
+				if previous[j] != nil {
+					undoErr = s.store.PutReceipt(previous[j])
+				} else {
+					undoErr = s.store.DeleteReceipt(tickets[j].PurchaseId)
+				}
+				if undoErr != nil {
+					log.Printf("booking store: rolling back %s: %v", tickets[j].PurchaseId, undoErr)
+				}
+			}
+			return err
+		}
+	}
+	return nil
+}
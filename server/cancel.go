@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Helper function to cancel a ticket: its seat, hold and waitlist place are
+// given up and the price paid, less the cancellation fee, is due back. A
+// ticket paid through the payment provider is left with its refund pending
+// for issueRefund to make. The receipt is kept so the cancellation stays
+// visible. Callers must hold s.mu for writing.
+func (s *Server) cancelTicket(receipt *pb.Receipt, reason string) error {
+	if err := checkTransition(receipt, pb.TicketStatus_TICKET_STATUS_CANCELLED, "cancel"); err != nil {
+		return err
+	}
+
+	departure, err := time.Parse(time.RFC3339, receipt.DepartureTime)
+	if err == nil && !departure.After(s.now()) {
+		return status.Errorf(codes.FailedPrecondition, "Train %s on %s has already left %s", receipt.TrainId, receipt.Date, receipt.From)
+	}
+
+	return s.withdrawTicket(receipt, reason, false)
+}
+
+// Helper function to cancel a ticket whatever its status, as cancelTicket
+// does once it has checked the ticket may be cancelled. With waiveFee the
+// whole price is due back. Callers must hold s.mu for writing.
+func (s *Server) withdrawTicket(receipt *pb.Receipt, reason string, waiveFee bool) error {
+	now := s.now()
+	departure, err := time.Parse(time.RFC3339, receipt.DepartureTime)
+	if err != nil {
+		// Receipts from before departure times were recorded are charged
+		// the fee for the least notice
+		departure = now
+	}
+
+	price := receipt.Price
+	if price == nil {
+		price = s.catalog.Pricing.Money(0)
+	}
+	fee, feeRule := s.catalog.Pricing.CancellationFee(price, departure, now)
+	if waiveFee {
+		fee, feeRule = &pb.Money{Currency: price.Currency}, "Fee waived"
+	}
+
+	previous := receipt.Seat
+	receipt.Cancellation = &pb.Cancellation{
+		CancelledAt: now.Format(time.RFC3339),
+		Reason:      reason,
+		Seat:        previous,
+		Fee:         fee,
+		FeeRule:     feeRule,
+		Refund:      &pb.Money{AmountMinor: price.AmountMinor - fee.AmountMinor, Currency: price.Currency},
+	}
+	receipt.Seat = &pb.Seat{}
+	receipt.Waitlist = nil
+	receipt.Status = pb.TicketStatus_TICKET_STATUS_CANCELLED
+	if receipt.Cancellation.Refund.AmountMinor > 0 {
+		if receipt.Payment == nil {
+			// Tickets sold before payments were taken are refunded by hand
+			receipt.Status = pb.TicketStatus_TICKET_STATUS_REFUNDED
+		} else {
+			receipt.Payment.State = pb.PaymentState_PAYMENT_STATE_REFUND_PENDING
+		}
+	}
+
+	if err := s.dropSwapRequests(receipt); err != nil {
+		return storeError(err)
+	}
+	if err := s.store.PutReceipt(receipt); err != nil {
+		return storeError(err)
+	}
+	s.releaseHold(receipt.PurchaseId)
+	s.emitSeat(eventSeatReleased, &pb.Receipt{TrainId: receipt.TrainId, Date: receipt.Date, Seat: previous})
+
+	// Offer the freed seat to the waitlist
+	s.serveWaitlist(receiptDeparture(receipt))
+	return nil
+}
+
+// Helper function to report whether a cancelled ticket is still owed a
+// refund that an earlier attempt failed to make, or whose outcome was never
+// recorded, e.g. because the server stopped while waiting for the provider.
+// Refunds are keyed by purchase id, so retrying one that did go through
+// does not pay it twice.
+func refundOutstanding(receipt *pb.Receipt) bool {
+	if receipt.Status != pb.TicketStatus_TICKET_STATUS_CANCELLED || receipt.Cancellation.GetRefund().GetAmountMinor() <= 0 {
+		return false
+	}
+	state := receipt.Payment.GetState()
+	return state == pb.PaymentState_PAYMENT_STATE_CAPTURED || state == pb.PaymentState_PAYMENT_STATE_REFUND_PENDING
+}
+
+// issueRefund asks the payment provider for the refund of a cancelled
+// ticket whose refund is pending, without holding s.mu while it waits. If
+// the provider fails, the payment goes back to captured with the error
+// recorded, so cancelling again retries it. Returns the receipt as stored
+// afterwards.
+func (s *Server) issueRefund(ctx context.Context, receipt *pb.Receipt) (*pb.Receipt, error) {
+	if receipt.Payment.GetState() != pb.PaymentState_PAYMENT_STATE_REFUND_PENDING {
+		return receipt, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.paymentTimeout)
+	refundReference, refundErr := s.payments.Refund(ctx, receipt.Payment.Reference, receipt.PurchaseId, receipt.Cancellation.Refund)
+	cancel()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	purchaseID := receipt.PurchaseId
+	receipt, ok := s.store.GetReceipt(purchaseID)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Purchase not found: %s", purchaseID)
+	}
+	s.refreshStatus(receipt)
+
+	if refundErr != nil {
+		log.Printf("payment provider: refunding purchase %s: %v", receipt.PurchaseId, refundErr)
+		receipt.Payment.State = pb.PaymentState_PAYMENT_STATE_CAPTURED
+		receipt.Payment.LastError = refundErr.Error()
+	} else {
+		receipt.Payment.State = pb.PaymentState_PAYMENT_STATE_REFUNDED
+		receipt.Payment.RefundReference = refundReference
+		receipt.Payment.LastError = ""
+		receipt.Status = pb.TicketStatus_TICKET_STATUS_REFUNDED
+	}
+	if err := s.store.PutReceipt(receipt); err != nil {
+		return nil, storeError(err)
+	}
+
+	return receipt, nil
+}
+
+func (s *Server) CancelTicket(ctx context.Context, req *pb.CancelTicketRequest) (*pb.CancelTicketResponse, error) {
+	// Validate the request
+	if req == nil || req.PurchaseId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Purchase id cannot be empty")
+	}
+
+	s.mu.Lock()
+	receipt, err := s.lookupOwnTicket(ctx, req.PurchaseId, "")
+	if err == nil {
+		if refundOutstanding(receipt) {
+			// Retry the refund an earlier cancellation could not make
+			receipt.Payment.State = pb.PaymentState_PAYMENT_STATE_REFUND_PENDING
+			if err = s.store.PutReceipt(receipt); err != nil {
+				err = storeError(err)
+			}
+		} else {
+			err = s.cancelTicket(receipt, req.Reason)
+		}
+	}
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err = s.issueRefund(ctx, receipt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CancelTicketResponse{Receipt: receipt}, nil
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// dateLayout is the format of departure dates in requests and receipts.
+const dateLayout = "2006-01-02"
+
+// How far ahead the server looks for the next departure of a train
+const departureSearchDays = 14
+
+// Catalog lists every train the service sells tickets for. Sections defined
+// at the top level are the default layout for trains that do not define
+// their own.
+type Catalog struct {
+	Layout  `yaml:",inline"`
+	Trains  []*Train `yaml:"trains"`
+	Pricing *Pricing `yaml:"pricing"`
+
+	stations map[string]bool
+}
+
+// Train is a scheduled service along a fixed route.
+type Train struct {
+	ID      string   `yaml:"id"`
+	Name    string   `yaml:"name"`
+	Departs string   `yaml:"departs"`
+	Days    []string `yaml:"days"`
+	Stops   []Stop   `yaml:"stops"`
+	Layout  `yaml:",inline"`
+
+	departs time.Duration
+	days    map[time.Weekday]bool
+}
+
+// Stop is a station on a train's route, reached Minutes after the train
+// leaves its origin and Km along the line from it.
+type Stop struct {
+	Station string `yaml:"station"`
+	Minutes int    `yaml:"minutes"`
+	Km      int    `yaml:"km"`
+}
+
+// Departure identifies one run of a train on a given date.
+type Departure struct {
+	TrainID string
+	Date    string
+}
+
+func (d Departure) String() string {
+	return d.TrainID + "/" + d.Date
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// defaultCatalog is used when no catalog file is configured: a single daily
+// train from London to Brussels via Paris with the default seat layout.
+func defaultCatalog() *Catalog {
+	catalog := &Catalog{
+		Layout: *defaultLayout(),
+		Trains: []*Train{
+			{
+				ID:      "T1",
+				Name:    "London - Paris - Brussels",
+				Departs: "09:00",
+				Stops: []Stop{
+					{Station: "London", Minutes: 0, Km: 0},
+					{Station: "Paris", Minutes: 140, Km: 492},
+					{Station: "Brussels", Minutes: 225, Km: 802},
+				},
+			},
+		},
+		Pricing: defaultPricing(),
+	}
+	if err := catalog.validate(); err != nil {
+		panic(err)
+	}
+	return catalog
+}
+
+// loadCatalog reads and validates a YAML catalog file.
+func loadCatalog(path string) (*Catalog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read catalog: %w", err)
+	}
+
+	catalog := &Catalog{}
+	if err := yaml.Unmarshal(data, catalog); err != nil {
+		return nil, fmt.Errorf("parse catalog %s: %w", path, err)
+	}
+	if err := catalog.complete(); err != nil {
+		return nil, fmt.Errorf("catalog %s: %w", path, err)
+	}
+	return catalog, nil
+}
+
+// complete gives a catalog read from YAML the default layout and pricing
+// if it has none, then validates it.
+func (c *Catalog) complete() error {
+	if len(c.Sections) == 0 {
+		c.Layout = *defaultLayout()
+	}
+	if c.Pricing == nil {
+		c.Pricing = defaultPricing()
+	}
+	return c.validate()
+}
+
+// validate checks every train and fills in derived fields.
+func (c *Catalog) validate() error {
+	if err := c.Layout.validate(); err != nil {
+		return err
+	}
+	if len(c.Trains) == 0 {
+		return fmt.Errorf("no trains defined")
+	}
+
+	c.stations = make(map[string]bool)
+	classes := make(map[string]bool)
+	ids := make(map[string]bool)
+	for i, train := range c.Trains {
+		if train == nil || train.ID == "" {
+			return fmt.Errorf("train %d has no id", i+1)
+		}
+		if ids[train.ID] {
+			return fmt.Errorf("train %s defined twice", train.ID)
+		}
+		ids[train.ID] = true
+
+		if err := train.validate(&c.Layout); err != nil {
+			return fmt.Errorf("train %s: %w", train.ID, err)
+		}
+		for _, stop := range train.Stops {
+			c.stations[stop.Station] = true
+		}
+		for _, section := range train.Sections {
+			classes[section.Class] = true
+		}
+	}
+
+	if err := c.Pricing.validate(classes); err != nil {
+		return fmt.Errorf("pricing: %w", err)
+	}
+	return nil
+}
+
+// Helper function to check a train and derive its schedule
+func (t *Train) validate(defaultLayout *Layout) error {
+	if t.Name == "" {
+		t.Name = t.ID
+	}
+
+	departs, err := time.Parse("15:04", t.Departs)
+	if err != nil {
+		return fmt.Errorf("departs must be HH:MM, got %q", t.Departs)
+	}
+	t.departs = time.Duration(departs.Hour())*time.Hour + time.Duration(departs.Minute())*time.Minute
+
+	t.days = make(map[time.Weekday]bool)
+	for _, day := range t.Days {
+		weekday, ok := weekdays[strings.ToLower(day)]
+		if !ok {
+			return fmt.Errorf("unknown day %q", day)
+		}
+		t.days[weekday] = true
+	}
+	if len(t.days) == 0 {
+		for _, weekday := range weekdays {
+			t.days[weekday] = true
+		}
+	}
+
+	if len(t.Stops) < 2 {
+		return fmt.Errorf("route needs at least two stops")
+	}
+	seen := make(map[string]bool)
+	for i, stop := range t.Stops {
+		if stop.Station == "" {
+			return fmt.Errorf("stop %d has no station", i+1)
+		}
+		if seen[stop.Station] {
+			return fmt.Errorf("station %s visited twice", stop.Station)
+		}
+		seen[stop.Station] = true
+		if i == 0 && (stop.Minutes != 0 || stop.Km != 0) {
+			return fmt.Errorf("first stop must be at 0 minutes and 0 km")
+		}
+		if i > 0 && stop.Minutes <= t.Stops[i-1].Minutes {
+			return fmt.Errorf("stop %s must be later than %s", stop.Station, t.Stops[i-1].Station)
+		}
+		if i > 0 && stop.Km < t.Stops[i-1].Km {
+			return fmt.Errorf("stop %s cannot be nearer the origin than %s", stop.Station, t.Stops[i-1].Station)
+		}
+	}
+
+	if len(t.Sections) == 0 {
+		t.Layout = *defaultLayout
+		return nil
+	}
+	return t.Layout.validate()
+}
+
+// Train returns the train with the given id, or nil.
+func (c *Catalog) Train(id string) *Train {
+	for _, train := range c.Trains {
+		if train.ID == id {
+			return train
+		}
+	}
+	return nil
+}
+
+// HasStation reports whether any train calls at station.
+func (c *Catalog) HasStation(station string) bool {
+	return c.stations[station]
+}
+
+// StopIndex returns the position of station on the route, or -1.
+func (t *Train) StopIndex(station string) int {
+	for i, stop := range t.Stops {
+		if stop.Station == station {
+			return i
+		}
+	}
+	return -1
+}
+
+// Serves reports whether the train travels from one station to the other.
+func (t *Train) Serves(from, to string) bool {
+	fromIndex, toIndex := t.StopIndex(from), t.StopIndex(to)
+	return fromIndex >= 0 && toIndex > fromIndex
+}
+
+// RunsOn reports whether the train departs its origin on date.
+func (t *Train) RunsOn(date time.Time) bool {
+	return t.days[date.Weekday()]
+}
+
+// StopTime returns when the train calls at station on the run that leaves
+// its origin on date.
+func (t *Train) StopTime(date time.Time, station string) time.Time {
+	origin := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location()).Add(t.departs)
+	return origin.Add(time.Duration(t.Stops[t.StopIndex(station)].Minutes) * time.Minute)
+}
+
+// NextDeparture returns the date of the first run that calls at station
+// after now.
+func (t *Train) NextDeparture(now time.Time, station string) (time.Time, bool) {
+	for i := 0; i <= departureSearchDays; i++ {
+		date := now.AddDate(0, 0, i)
+		if t.RunsOn(date) && t.StopTime(date, station).After(now) {
+			return date, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseDate parses a departure date in the server's local time zone.
+func parseDate(date string) (time.Time, error) {
+	return time.ParseInLocation(dateLayout, date, time.Local)
+}
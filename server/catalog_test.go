@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Helper function to load a catalog from YAML written to a temporary file
+func loadTestCatalog(t *testing.T, data string) (*Catalog, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "catalog.yaml")
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatalf("write catalog: %v", err)
+	}
+	return loadCatalog(path)
+}
+
+// Route shared by the test catalogs
+const testRoute = `
+trains:
+  - id: T1
+    departs: "09:00"
+    stops:
+      - {station: London, minutes: 0, km: 0}
+      - {station: Paris, minutes: 140, km: 492}
+`
+
+func TestLoadCatalogChecksSeatClasses(t *testing.T) {
+	tests := []struct {
+		name     string
+		sections string
+		wantErr  string
+	}{
+		{"priced classes", `
+sections:
+  - {name: A, class: first, rows: 2, columns: "A|B"}
+  - {name: B, class: standard, rows: 2, columns: "A|B"}
+`, ""},
+		{"no class", `
+sections:
+  - {name: A, rows: 2, columns: "A|B"}
+`, "section A has no class"},
+		{"unpriced class", `
+sections:
+  - {name: A, class: frist, rows: 2, columns: "A|B"}
+`, "no fare defined for seat class frist"},
+	}
+	for _, tt := range tests {
+		_, err := loadTestCatalog(t, tt.sections+testRoute)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: loadCatalog: %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: got error %v, want one containing %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestRequestedSeatClassMustBeKnown(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		seatClass string
+		wantCode  codes.Code
+		wantClass string
+		wantErr   string
+	}{
+		{"", codes.OK, "standard", ""},
+		{"standard", codes.OK, "standard", ""},
+		{"first", codes.InvalidArgument, "", "Train T1 has no first class seats"},
+		{"frist", codes.InvalidArgument, "", `Unknown seat class "frist"`},
+	}
+	for _, tt := range tests {
+		response, err := s.QuoteFare(adminContext(), &pb.QuoteFareRequest{From: "London", To: "Paris", SeatClass: tt.seatClass})
+		if status.Code(err) != tt.wantCode {
+			t.Errorf("seat class %q: got %v, want %v", tt.seatClass, err, tt.wantCode)
+			continue
+		}
+		if err != nil {
+			if msg := status.Convert(err).Message(); msg != tt.wantErr {
+				t.Errorf("seat class %q: got message %q, want %q", tt.seatClass, msg, tt.wantErr)
+			}
+			continue
+		}
+		if response.SeatClass != tt.wantClass {
+			t.Errorf("seat class %q: quoted for %q, want %q", tt.seatClass, response.SeatClass, tt.wantClass)
+		}
+	}
+}
+
+// Helper function to make a server selling a daily train T1 from London to
+// Brussels via Paris at 09:00 and a weekday train T2 from London to Paris
+// at 17:00, with the clock at 10:00 on Friday 7 June 2024
+func newTimetableServer(t *testing.T) *Server {
+	t.Helper()
+	catalog, err := loadTestCatalog(t, `
+trains:
+  - id: T1
+    departs: "09:00"
+    stops:
+      - {station: London, minutes: 0, km: 0}
+      - {station: Paris, minutes: 140, km: 492}
+      - {station: Brussels, minutes: 225, km: 802}
+  - id: T2
+    departs: "17:00"
+    days: [mon, tue, wed, thu, fri]
+    stops:
+      - {station: London, minutes: 0, km: 0}
+      - {station: Paris, minutes: 140, km: 492}
+`)
+	if err != nil {
+		t.Fatalf("loadCatalog: %v", err)
+	}
+	store := newMemoryStore()
+	s := newServer(store, store, store, catalog)
+	clock := &fakeClock{now: time.Date(2024, 6, 7, 10, 0, 0, 0, time.Local)}
+	s.now = clock.Now
+	return s
+}
+
+func TestTrainValidation(t *testing.T) {
+	london, paris := Stop{Station: "London"}, Stop{Station: "Paris", Minutes: 140, Km: 492}
+
+	tests := []struct {
+		name    string
+		train   Train
+		wantErr string
+	}{
+		{"valid", Train{ID: "T1", Departs: "09:00", Days: []string{"Mon", "sat"}, Stops: []Stop{london, paris}}, ""},
+		{"bad departure time", Train{ID: "T1", Departs: "9am", Stops: []Stop{london, paris}}, `departs must be HH:MM, got "9am"`},
+		{"unknown day", Train{ID: "T1", Departs: "09:00", Days: []string{"someday"}, Stops: []Stop{london, paris}}, `unknown day "someday"`},
+		{"one stop", Train{ID: "T1", Departs: "09:00", Stops: []Stop{london}}, "route needs at least two stops"},
+		{"unnamed stop", Train{ID: "T1", Departs: "09:00", Stops: []Stop{london, Stop{Minutes: 10}}}, "stop 2 has no station"},
+		{"repeated station", Train{ID: "T1", Departs: "09:00", Stops: []Stop{london, paris, Stop{Station: "London", Minutes: 200, Km: 900}}}, "station London visited twice"},
+		{"origin offset", Train{ID: "T1", Departs: "09:00", Stops: []Stop{Stop{Station: "London", Minutes: 5}, paris}}, "first stop must be at 0 minutes and 0 km"},
+		{"stop not later", Train{ID: "T1", Departs: "09:00", Stops: []Stop{london, Stop{Station: "Paris"}}}, "stop Paris must be later than London"},
+		{"stop nearer origin", Train{ID: "T1", Departs: "09:00", Stops: []Stop{london, paris, Stop{Station: "Lille", Minutes: 200, Km: 100}}}, "stop Lille cannot be nearer the origin than Paris"},
+	}
+	for _, tt := range tests {
+		err := tt.train.validate(defaultLayout())
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: validate: %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tt.wantErr {
+			t.Errorf("%s: got error %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestResolveJourney(t *testing.T) {
+	s := newTimetableServer(t)
+
+	tests := []struct {
+		trainID, date, from, to string
+		wantTrain, wantDate     string
+		wantCode                codes.Code
+	}{
+		// T1 has left London for today but not yet reached Paris
+		{"", "", "London", "Paris", "T1", "2024-06-08", codes.OK},
+		{"", "", "Paris", "Brussels", "T1", "2024-06-07", codes.OK},
+		{"T2", "", "London", "Paris", "T2", "2024-06-07", codes.OK},
+		{"T2", "2024-06-10", "London", "Paris", "T2", "2024-06-10", codes.OK},
+		{"T2", "2024-06-08", "London", "Paris", "", "", codes.FailedPrecondition},
+		{"T1", "2024-06-07", "London", "Paris", "", "", codes.FailedPrecondition},
+		{"T1", "07/06/2024", "London", "Paris", "", "", codes.InvalidArgument},
+		{"T2", "", "Paris", "Brussels", "", "", codes.InvalidArgument},
+		{"", "", "London", "Berlin", "", "", codes.InvalidArgument},
+		{"", "", "London", "", "", "", codes.InvalidArgument},
+		{"", "", "Paris", "London", "", "", codes.NotFound},
+		{"T9", "", "London", "Paris", "", "", codes.NotFound},
+	}
+	for _, tt := range tests {
+		train, date, err := s.resolveJourney(tt.trainID, tt.date, tt.from, tt.to)
+		if status.Code(err) != tt.wantCode {
+			t.Errorf("train %q on %q from %s to %s: got %v, want %v", tt.trainID, tt.date, tt.from, tt.to, err, tt.wantCode)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if train.ID != tt.wantTrain || date.Format(dateLayout) != tt.wantDate {
+			t.Errorf("train %q on %q from %s to %s: got %s on %s, want %s on %s",
+				tt.trainID, tt.date, tt.from, tt.to, train.ID, date.Format(dateLayout), tt.wantTrain, tt.wantDate)
+		}
+	}
+}
+
+func TestSearchJourneys(t *testing.T) {
+	s := newTimetableServer(t)
+
+	tests := []struct {
+		date, from, to string
+		wantTrains     []string
+	}{
+		{"", "London", "Paris", []string{"T2"}},
+		{"2024-06-08", "London", "Paris", []string{"T1"}},
+		{"2024-06-10", "London", "Paris", []string{"T1", "T2"}},
+		{"", "Paris", "Brussels", []string{"T1"}},
+		{"", "Brussels", "London", nil},
+	}
+	for _, tt := range tests {
+		response, err := s.SearchJourneys(context.Background(), &pb.SearchJourneysRequest{Date: tt.date, From: tt.from, To: tt.to})
+		if err != nil {
+			t.Fatalf("SearchJourneys(%q, %s, %s): %v", tt.date, tt.from, tt.to, err)
+		}
+		var trains []string
+		for _, journey := range response.Journeys {
+			trains = append(trains, journey.TrainId)
+			if journey.AvailableSeats != 20 {
+				t.Errorf("%s on %q: got %d seats available, want 20", journey.TrainId, tt.date, journey.AvailableSeats)
+			}
+		}
+		if !slices.Equal(trains, tt.wantTrains) {
+			t.Errorf("%q from %s to %s: got trains %v, want %v", tt.date, tt.from, tt.to, trains, tt.wantTrains)
+		}
+	}
+
+	// Times are at the passenger's stations
+	response, err := s.SearchJourneys(context.Background(), &pb.SearchJourneysRequest{From: "Paris", To: "Brussels"})
+	if err != nil {
+		t.Fatalf("SearchJourneys: %v", err)
+	}
+	journey := response.Journeys[0]
+	wantDeparture := time.Date(2024, 6, 7, 11, 20, 0, 0, time.Local).Format(time.RFC3339)
+	wantArrival := time.Date(2024, 6, 7, 12, 45, 0, 0, time.Local).Format(time.RFC3339)
+	if journey.DepartureTime != wantDeparture || journey.ArrivalTime != wantArrival {
+		t.Fatalf("got %s to %s, want %s to %s", journey.DepartureTime, journey.ArrivalTime, wantDeparture, wantArrival)
+	}
+
+	_, err = s.SearchJourneys(context.Background(), &pb.SearchJourneysRequest{From: "London", To: "Paris", Date: "tomorrow"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("SearchJourneys with a bad date: got %v, want InvalidArgument", err)
+	}
+}
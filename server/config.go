@@ -0,0 +1,354 @@
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"gopkg.in/yaml.v3"
+)
+
+// What secrets are replaced with when the configuration is printed
+const redacted = "REDACTED"
+
+// Config is everything the server is configured with. Each setting is
+// taken from the first of these that sets it: the command-line flags, the
+// TRAIN_* environment variables named after the flags, the YAML file given
+// with -config, and the defaults.
+type Config struct {
+	Listen   string        `yaml:"listen"`
+	HTTPAddr string        `yaml:"http_addr"`
+	TLS      TLSConfig     `yaml:"tls"`
+	Store    StoreConfig   `yaml:"store"`
+	Catalog  CatalogConfig `yaml:"catalog,omitempty"`
+	Auth     AuthConfig    `yaml:"auth"`
+	Timeouts TimeoutConfig `yaml:"timeouts"`
+
+	// The catalog loaded by validate
+	catalog *Catalog
+}
+
+// TLSConfig is the certificate the server presents. Without one the server
+// accepts plaintext connections only.
+type TLSConfig struct {
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+}
+
+// StoreConfig is where bookings are kept.
+type StoreConfig struct {
+	Kind string `yaml:"kind"`
+	Path string `yaml:"path"`
+}
+
+// AuthConfig is how callers sign in.
+type AuthConfig struct {
+	AdminSecret string        `yaml:"admin_secret"`
+	Secret      string        `yaml:"secret"`
+	TokenTTL    time.Duration `yaml:"token_ttl"`
+}
+
+// TimeoutConfig is how long the server waits for things and keeps them.
+type TimeoutConfig struct {
+	HoldTTL           time.Duration `yaml:"hold_ttl"`
+	IdempotencyWindow time.Duration `yaml:"idempotency_window"`
+	Payment           time.Duration `yaml:"payment"`
+	DrainDelay        time.Duration `yaml:"drain_delay"`
+}
+
+// CatalogConfig is the train catalog: the path of a catalog file, or the
+// catalog itself written out in the config file. Neither means the default
+// catalog.
+type CatalogConfig struct {
+	Path string
+
+	inline *yaml.Node
+}
+
+// defaultConfig is the configuration before any file, environment variable
+// or flag changes it.
+func defaultConfig() *Config {
+	return &Config{
+		Listen:   ":8080",
+		HTTPAddr: ":8081",
+		Store:    StoreConfig{Kind: "memory", Path: "bookings.log"},
+		Auth:     AuthConfig{TokenTTL: defaultTokenTTL},
+		Timeouts: TimeoutConfig{
+			HoldTTL:           defaultHoldTTL,
+			IdempotencyWindow: defaultIdempotencyWindow,
+			Payment:           defaultPaymentTimeout,
+		},
+	}
+}
+
+// flagSet returns the flags that set the configuration, bound to c.
+func (c *Config) flagSet(name string, errorHandling flag.ErrorHandling) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, errorHandling)
+	fs.StringVar(&c.Listen, "listen", c.Listen, "address the gRPC server listens on")
+	fs.StringVar(&c.HTTPAddr, "http-addr", c.HTTPAddr, "address the REST/JSON gateway listens on; empty disables it")
+	fs.StringVar(&c.TLS.CertFile, "tls-cert", c.TLS.CertFile, "PEM certificate to serve TLS with (needs -tls-key; default plaintext)")
+	fs.StringVar(&c.TLS.KeyFile, "tls-key", c.TLS.KeyFile, "PEM private key of -tls-cert")
+	fs.StringVar(&c.Store.Kind, "store", c.Store.Kind, "booking storage backend: memory or file")
+	fs.StringVar(&c.Store.Path, "store-path", c.Store.Path, "booking log path for the file store")
+	fs.Var(&c.Catalog, "catalog", "YAML train catalog file (default: one daily London - Paris - Brussels train)")
+	fs.StringVar(&c.Auth.AdminSecret, "admin-secret", c.Auth.AdminSecret, "secret admins sign in with (empty disables admin sign-in)")
+	fs.StringVar(&c.Auth.Secret, "auth-secret", c.Auth.Secret, "secret that signs access tokens (empty picks a random one, so tokens do not survive a restart)")
+	fs.DurationVar(&c.Auth.TokenTTL, "token-ttl", c.Auth.TokenTTL, "how long an access token from Login is accepted")
+	fs.DurationVar(&c.Timeouts.HoldTTL, "hold-ttl", c.Timeouts.HoldTTL, "how long HoldSeat reserves a seat before it is released")
+	fs.DurationVar(&c.Timeouts.IdempotencyWindow, "idempotency-window", c.Timeouts.IdempotencyWindow, "how long the response to a call made with an idempotency key is remembered")
+	fs.DurationVar(&c.Timeouts.Payment, "payment-timeout", c.Timeouts.Payment, "how long to wait for the payment provider")
+	fs.DurationVar(&c.Timeouts.DrainDelay, "drain-delay", c.Timeouts.DrainDelay, "how long to report not serving before stopping, so load balancers move traffic away first")
+	return fs
+}
+
+// loadConfig works out the configuration from the command-line arguments,
+// the environment and the config file, and validates it. It also reports
+// whether -print-config was given.
+func loadConfig(name string, args []string) (cfg *Config, printConfig bool, err error) {
+	// Parse the flags first to find the config file; they are applied
+	// again on top of it below
+	flags := defaultConfig().flagSet(name, flag.ExitOnError)
+	path := flags.String("config", os.Getenv("TRAIN_CONFIG"), "YAML config file (env TRAIN_CONFIG)")
+	flags.BoolVar(&printConfig, "print-config", false, "print the configuration in effect, with secrets redacted, and exit")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage of %s:\n", name)
+		flags.PrintDefaults()
+		fmt.Fprintf(flags.Output(), `
+Each flag can also be set with an environment variable named after it, e.g.
+TRAIN_HOLD_TTL for -hold-ttl, or in the config file (see server/config.yaml).
+Flags take precedence over environment variables, which take precedence over
+the config file.
+`)
+	}
+	flags.Parse(args)
+
+	cfg = defaultConfig()
+	if *path != "" {
+		if err := cfg.readFile(*path); err != nil {
+			return nil, false, err
+		}
+	}
+
+	settings := cfg.flagSet(name, flag.ContinueOnError)
+	settings.VisitAll(func(f *flag.Flag) {
+		// A variable set to nothing still counts for a text setting, e.g.
+		// TRAIN_HTTP_ADDR= to turn the gateway off, but is no duration
+		value, ok := os.LookupEnv(envName(f.Name))
+		if !ok || err != nil {
+			return
+		}
+		if value == "" && !takesText(f) {
+			err = fmt.Errorf("%s is set to nothing; give it a value or unset it", envName(f.Name))
+			return
+		}
+		if setErr := f.Value.Set(value); setErr != nil {
+			err = fmt.Errorf("invalid value %q for %s: %w", value, envName(f.Name), setErr)
+		}
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	flags.Visit(func(f *flag.Flag) {
+		if setting := settings.Lookup(f.Name); setting != nil {
+			setting.Value.Set(f.Value.String())
+		}
+	})
+
+	if err := cfg.validate(); err != nil {
+		return nil, false, err
+	}
+	return cfg, printConfig, nil
+}
+
+// Helper function to name the environment variable that sets a flag
+func envName(flagName string) string {
+	return "TRAIN_" + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// Helper function to tell whether a flag takes any text, so that setting it
+// to nothing means something. The -catalog flag takes a path.
+func takesText(f *flag.Flag) bool {
+	getter, ok := f.Value.(flag.Getter)
+	if !ok {
+		return true
+	}
+	_, ok = getter.Get().(string)
+	return ok
+}
+
+// readFile applies the settings in a YAML config file.
+func (c *Config) readFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+
+	// Reject unknown settings, so a misspelt one is not silently ignored
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("parse config %s: %w", path, err)
+	}
+	return nil
+}
+
+// validate checks the configuration and loads the catalog.
+func (c *Config) validate() error {
+	if c.Listen == "" {
+		return errors.New("listen cannot be empty")
+	}
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		return errors.New("tls-cert and tls-key must be set together")
+	}
+
+	switch c.Store.Kind {
+	case "memory":
+	case "file":
+		if c.Store.Path == "" {
+			return errors.New("store-path cannot be empty for the file store")
+		}
+	default:
+		return fmt.Errorf("unknown booking store %q", c.Store.Kind)
+	}
+
+	if c.Auth.TokenTTL <= 0 {
+		return errors.New("token-ttl must be positive")
+	}
+	if c.Timeouts.HoldTTL <= 0 {
+		return errors.New("hold-ttl must be positive")
+	}
+	if c.Timeouts.IdempotencyWindow <= 0 {
+		return errors.New("idempotency-window must be positive")
+	}
+	if c.Timeouts.Payment <= 0 {
+		return errors.New("payment-timeout must be positive")
+	}
+	if c.Timeouts.DrainDelay < 0 {
+		return errors.New("drain-delay cannot be negative")
+	}
+
+	catalog, err := c.Catalog.load()
+	if err != nil {
+		return fmt.Errorf("failed to load catalog: %w", err)
+	}
+	c.catalog = catalog
+	return nil
+}
+
+// print writes the configuration as YAML, in the form the config file takes.
+func (c *Config) print(w io.Writer) error {
+	printed := *c
+	if printed.Auth.AdminSecret != "" {
+		printed.Auth.AdminSecret = redacted
+	}
+	if printed.Auth.Secret != "" {
+		printed.Auth.Secret = redacted
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&printed); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+func (t TLSConfig) enabled() bool {
+	return t.CertFile != ""
+}
+
+// credentials returns what the server accepts connections with.
+func (t TLSConfig) credentials() (credentials.TransportCredentials, error) {
+	return credentials.NewServerTLSFromFile(t.CertFile, t.KeyFile)
+}
+
+// clientConfig returns a TLS configuration that trusts the server's own
+// certificate, for connecting to the server from the same process.
+func (t TLSConfig) clientConfig() (*tls.Config, error) {
+	pair, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, err
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(leaf)
+
+	// Ask for a name the certificate is for, whatever address is dialled
+	config := &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}
+	switch {
+	case len(leaf.DNSNames) > 0:
+		config.ServerName = leaf.DNSNames[0]
+	case len(leaf.IPAddresses) > 0:
+		config.ServerName = leaf.IPAddresses[0].String()
+	default:
+		return nil, errors.New("certificate names no host or IP address")
+	}
+	return config, nil
+}
+
+// UnmarshalYAML reads a catalog setting: a file path, or the catalog itself.
+func (c *CatalogConfig) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		*c = CatalogConfig{Path: node.Value}
+	case yaml.MappingNode:
+		*c = CatalogConfig{inline: node}
+	default:
+		return fmt.Errorf("line %d: catalog must be a file path or a catalog", node.Line)
+	}
+	return nil
+}
+
+// MarshalYAML writes a catalog setting the way it was given.
+func (c CatalogConfig) MarshalYAML() (interface{}, error) {
+	if c.inline != nil {
+		return c.inline, nil
+	}
+	return c.Path, nil
+}
+
+// IsZero reports whether the default catalog is used, so it is left out of
+// the printed configuration.
+func (c CatalogConfig) IsZero() bool {
+	return c.Path == "" && c.inline == nil
+}
+
+func (c *CatalogConfig) String() string {
+	return c.Path
+}
+
+// Set makes the catalog the one in a file, for the -catalog flag.
+func (c *CatalogConfig) Set(path string) error {
+	*c = CatalogConfig{Path: path}
+	return nil
+}
+
+// load reads and validates the configured catalog.
+func (c *CatalogConfig) load() (*Catalog, error) {
+	switch {
+	case c.inline != nil:
+		catalog := &Catalog{}
+		if err := c.inline.Decode(catalog); err != nil {
+			return nil, fmt.Errorf("parse catalog: %w", err)
+		}
+		if err := catalog.complete(); err != nil {
+			return nil, fmt.Errorf("catalog: %w", err)
+		}
+		return catalog, nil
+	case c.Path != "":
+		return loadCatalog(c.Path)
+	default:
+		return defaultCatalog(), nil
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConfigPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	file := `http_addr: ":9091"
+timeouts:
+  hold_ttl: 2m
+  idempotency_window: 3m
+  payment: 4s
+`
+	if err := os.WriteFile(path, []byte(file), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	// Set to nothing, TRAIN_HTTP_ADDR still turns the gateway off
+	t.Setenv("TRAIN_HTTP_ADDR", "")
+	t.Setenv("TRAIN_IDEMPOTENCY_WINDOW", "5m")
+	t.Setenv("TRAIN_PAYMENT_TIMEOUT", "6s")
+
+	cfg, _, err := loadConfig("server", []string{"-config", path, "-payment-timeout", "7s"})
+	if err != nil {
+		t.Fatalf("loadConfig: %v", err)
+	}
+
+	if cfg.Listen != ":8080" {
+		t.Errorf("listen = %q, want the default :8080", cfg.Listen)
+	}
+	if cfg.Timeouts.HoldTTL != 2*time.Minute {
+		t.Errorf("hold TTL = %v, want 2m from the file", cfg.Timeouts.HoldTTL)
+	}
+	if cfg.HTTPAddr != "" {
+		t.Errorf("http addr = %q, want it emptied by the environment", cfg.HTTPAddr)
+	}
+	if cfg.Timeouts.IdempotencyWindow != 5*time.Minute {
+		t.Errorf("idempotency window = %v, want 5m from the environment", cfg.Timeouts.IdempotencyWindow)
+	}
+	if cfg.Timeouts.Payment != 7*time.Second {
+		t.Errorf("payment timeout = %v, want 7s from the flag", cfg.Timeouts.Payment)
+	}
+}
+
+func TestEmptyEnvironmentVariableOnlySetsText(t *testing.T) {
+	t.Setenv("TRAIN_TOKEN_TTL", "")
+
+	_, _, err := loadConfig("server", nil)
+	if err == nil || !strings.Contains(err.Error(), "TRAIN_TOKEN_TTL is set to nothing") {
+		t.Fatalf("loadConfig with TRAIN_TOKEN_TTL empty: got %v, want it rejected by name", err)
+	}
+}
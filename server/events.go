@@ -0,0 +1,152 @@
+package main
+
+import (
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Kinds of seat event
+const (
+	eventSeatAllocated     = "seat_allocated"
+	eventSeatReleased      = "seat_released"
+	eventSeatHeld          = "seat_held"
+	eventHoldReleased      = "hold_released"
+	eventWaitlistAllocated = "waitlist_allocated"
+	eventSeatSwapped       = "seat_swapped"
+)
+
+// Updates buffered for each watcher. A watcher that falls this far behind
+// is disconnected rather than allowed to hold up bookings.
+const watchBufferSize = 64
+
+// seatEvent records a change to the availability of a seat.
+type seatEvent struct {
+	kind       string
+	departure  Departure
+	section    string
+	seatNumber int32
+}
+
+// seatWatcher is a WatchSeatAvailability stream waiting for updates. Once
+// updates is closed, slow tells whether the watcher was dropped for not
+// keeping up.
+type seatWatcher struct {
+	view    *seatMapView
+	updates chan *pb.SeatAvailabilityUpdate
+	slow    bool
+}
+
+// Helper function to check whether a watcher shows a section
+func (w *seatWatcher) watches(departure Departure, section string) bool {
+	if w.view.departure != departure {
+		return false
+	}
+	for _, watched := range w.view.sections {
+		if watched.Name == section {
+			return true
+		}
+	}
+	return false
+}
+
+// emit publishes a seat event to every watcher of the seat. Watchers that
+// cannot take another update are dropped instead of blocking the caller.
+// Callers must hold s.mu for writing.
+func (s *Server) emit(event seatEvent) {
+	for watcher := range s.watchers {
+		if !watcher.watches(event.departure, event.section) {
+			continue
+		}
+
+		section := watcher.view.train.Section(event.section)
+		if section == nil || !section.HasSeat(event.seatNumber) {
+			continue
+		}
+		seat := section.Seats()[event.seatNumber-1]
+		change := &pb.SeatChange{
+			Section: section.Name,
+			Seat:    s.seatInfo(watcher.view, section, seat),
+			Reason:  event.kind,
+		}
+		for _, other := range section.Seats() {
+			if s.seatFree(watcher.view.train, watcher.view.departure, section.Name, other.Number, watcher.view.leg) {
+				change.Available++
+			}
+		}
+
+		select {
+		case watcher.updates <- &pb.SeatAvailabilityUpdate{Update: &pb.SeatAvailabilityUpdate_Change{Change: change}}:
+		default:
+			watcher.slow = true
+			s.unwatch(watcher)
+		}
+	}
+}
+
+// Helper function to publish an event for the seat a receipt holds.
+// Callers must hold s.mu for writing.
+func (s *Server) emitSeat(kind string, receipt *pb.Receipt) {
+	if key, ok := receiptSeat(receipt); ok {
+		s.emit(seatEvent{
+			kind:       kind,
+			departure:  key.departure,
+			section:    key.section,
+			seatNumber: key.seatNumber,
+		})
+	}
+}
+
+// Helper function to stop sending updates to a watcher.
+// Callers must hold s.mu for writing.
+func (s *Server) unwatch(watcher *seatWatcher) {
+	if _, ok := s.watchers[watcher]; ok {
+		delete(s.watchers, watcher)
+		close(watcher.updates)
+	}
+}
+
+func (s *Server) WatchSeatAvailability(req *pb.WatchSeatAvailabilityRequest, stream pb.TicketService_WatchSeatAvailabilityServer) error {
+	// Validate the request
+	if req == nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	view, err := s.resolveSeatMapView(req.TrainId, req.Date, req.Section, req.From, req.To)
+	if err != nil {
+		return err
+	}
+
+	// Take the snapshot and subscribe together so no change falls between them
+	watcher := &seatWatcher{
+		view:    view,
+		updates: make(chan *pb.SeatAvailabilityUpdate, watchBufferSize),
+	}
+	s.mu.Lock()
+	watcher.updates <- &pb.SeatAvailabilityUpdate{Update: &pb.SeatAvailabilityUpdate_Snapshot{Snapshot: s.seatMap(view)}}
+	s.watchers[watcher] = struct{}{}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		s.unwatch(watcher)
+		s.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case update, ok := <-watcher.updates:
+			if !ok {
+				if watcher.slow {
+					return status.Errorf(codes.ResourceExhausted, "Too many updates pending; reconnect to get a fresh snapshot")
+				}
+				return nil
+			}
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchStream is the server side of a WatchSeatAvailability call. Sent
+// updates are passed on to updates, which is unbuffered so a test that
+// stops reading holds the stream up.
+type watchStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	updates chan *pb.SeatAvailabilityUpdate
+}
+
+func (w *watchStream) Context() context.Context {
+	return w.ctx
+}
+
+func (w *watchStream) Send(update *pb.SeatAvailabilityUpdate) error {
+	select {
+	case w.updates <- update:
+		return nil
+	case <-w.ctx.Done():
+		return w.ctx.Err()
+	}
+}
+
+// Helper function to start watching seat availability. It returns the
+// stream and a channel that gets the call's result once it ends.
+func watchSeats(t *testing.T, s *Server, req *pb.WatchSeatAvailabilityRequest) (*watchStream, <-chan error) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	stream := &watchStream{ctx: ctx, updates: make(chan *pb.SeatAvailabilityUpdate)}
+	done := make(chan error, 1)
+	go func() { done <- s.WatchSeatAvailability(req, stream) }()
+	return stream, done
+}
+
+// Helper function to wait for the next update sent on a stream
+func nextUpdate(t *testing.T, stream *watchStream) *pb.SeatAvailabilityUpdate {
+	t.Helper()
+	select {
+	case update := <-stream.updates:
+		return update
+	case <-time.After(time.Second):
+		t.Fatal("no seat availability update was sent")
+		return nil
+	}
+}
+
+// Helper function to check that nothing more is waiting to be sent
+func checkNoUpdate(t *testing.T, stream *watchStream) {
+	t.Helper()
+	select {
+	case update := <-stream.updates:
+		t.Fatalf("got unexpected update %v", update)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestWatchSeatAvailabilityReportsChanges(t *testing.T) {
+	s := newTestServer(t)
+	clock := &fakeClock{now: time.Now()}
+	s.now = clock.Now
+	ctx := adminContext()
+
+	stream, _ := watchSeats(t, s, &pb.WatchSeatAvailabilityRequest{Section: "A"})
+	snapshot := nextUpdate(t, stream).GetSnapshot()
+	if snapshot == nil || len(snapshot.Sections) != 1 || snapshot.Sections[0].Available != 10 {
+		t.Fatalf("got first update %v, want a snapshot of section A with 10 seats free", snapshot)
+	}
+
+	var first, second string
+	type change struct {
+		reason    string
+		seat      int32
+		occupied  bool
+		available int32
+	}
+	tests := []struct {
+		name   string
+		action func() error
+		want   []change
+	}{
+		{"allocate", func() error {
+			first = purchaseTicket(t, s, "first@example.com", "London", "Paris").PurchaseId
+			_, err := s.AllocateSeat(ctx, &pb.AllocateSeatRequest{PurchaseId: first, Section: "A"})
+			return err
+		}, []change{{eventSeatAllocated, 1, true, 9}}},
+		{"hold", func() error {
+			second = purchaseTicket(t, s, "second@example.com", "London", "Paris").PurchaseId
+			_, err := s.HoldSeat(ctx, &pb.HoldSeatRequest{PurchaseId: second, Section: "A", SeatNumber: 5})
+			return err
+		}, []change{{eventSeatHeld, 5, true, 8}}},
+		{"hold expires", func() error {
+			clock.Advance(s.holdTTL)
+			s.releaseExpiredHolds()
+			return nil
+		}, []change{{eventHoldReleased, 5, false, 9}}},
+		// Both changes are sent once the passenger has moved
+		{"change seat", func() error {
+			_, err := s.ModifySeat(ctx, &pb.ModifySeatRequest{PurchaseId: first, NewSection: "A", NewSeatNumber: 3})
+			return err
+		}, []change{{eventSeatReleased, 1, false, 9}, {eventSeatAllocated, 3, true, 9}}},
+		{"section B", func() error {
+			_, err := s.AllocateSeat(ctx, &pb.AllocateSeatRequest{PurchaseId: second, Section: "B"})
+			return err
+		}, nil},
+		{"cancel", func() error {
+			_, err := s.CancelTicket(ctx, &pb.CancelTicketRequest{PurchaseId: first})
+			return err
+		}, []change{{eventSeatReleased, 3, false, 10}}},
+	}
+	for _, tt := range tests {
+		if err := tt.action(); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		for _, want := range tt.want {
+			got := nextUpdate(t, stream).GetChange()
+			if got == nil || got.Reason != want.reason || got.Section != "A" || got.Seat.SeatNumber != want.seat ||
+				got.Seat.Occupied != want.occupied || got.Available != want.available {
+				t.Fatalf("%s: got change %v, want %s of A-%d (occupied %v) leaving %d free",
+					tt.name, got, want.reason, want.seat, want.occupied, want.available)
+			}
+		}
+		checkNoUpdate(t, stream)
+	}
+}
+
+func TestWatchSeatAvailabilityShowsTheWatchedLeg(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		from, to     string
+		wantOccupied bool
+	}{
+		{"", "", true},
+		{"London", "Paris", true},
+		{"Paris", "Brussels", false},
+	}
+	for _, tt := range tests {
+		stream, _ := watchSeats(t, s, &pb.WatchSeatAvailabilityRequest{Section: "A", From: tt.from, To: tt.to})
+		nextUpdate(t, stream)
+
+		seatedInA(t, s, fmt.Sprintf("%s-%s@example.com", tt.from, tt.to), "London", "Paris")
+		change := nextUpdate(t, stream).GetChange()
+		if change == nil || change.Seat.Occupied != tt.wantOccupied {
+			t.Errorf("watching %q to %q: got change %v, want occupied %v", tt.from, tt.to, change, tt.wantOccupied)
+		}
+	}
+}
+
+func TestSlowSeatWatcherIsDisconnected(t *testing.T) {
+	s := newTestServer(t)
+	stream, done := watchSeats(t, s, &pb.WatchSeatAvailabilityRequest{})
+	departure := Departure{TrainID: "T1", Date: nextUpdate(t, stream).GetSnapshot().GetDate()}
+
+	// Without reading, send updates until the watcher's buffer overflows
+	sent := 0
+	for dropped := false; !dropped; sent++ {
+		if sent > 2*watchBufferSize {
+			t.Fatalf("watcher still connected after %d unread updates", sent)
+		}
+		s.mu.Lock()
+		s.emit(seatEvent{kind: eventSeatReleased, departure: departure, section: "A", seatNumber: 1})
+		dropped = len(s.watchers) == 0
+		s.mu.Unlock()
+	}
+	if sent <= watchBufferSize {
+		t.Fatalf("watcher dropped after %d updates, want more than the %d buffered", sent, watchBufferSize)
+	}
+
+	// The updates buffered before it was dropped are still delivered
+	delivered := 0
+	for {
+		select {
+		case <-stream.updates:
+			delivered++
+			continue
+		case err := <-done:
+			if status.Code(err) != codes.ResourceExhausted {
+				t.Fatalf("slow watcher ended with %v, want ResourceExhausted", err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("slow watcher was not disconnected")
+		}
+		break
+	}
+	if delivered != sent-1 {
+		t.Fatalf("delivered %d of the %d updates sent, want all but the one that overflowed", delivered, sent)
+	}
+}
@@ -0,0 +1,321 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// logRecord is one line of the booking log.
+// Deletes are keyed by purchase ID; logs written before receipts were keyed
+// by purchase ID carry the passenger's email instead.
+type logRecord struct {
+	Op         string          `json:"op"`
+	PurchaseID string          `json:"purchase_id,omitempty"`
+	Email      string          `json:"email,omitempty"`
+	Receipt    json.RawMessage `json:"receipt,omitempty"`
+	Promo      json.RawMessage `json:"promo,omitempty"`
+	Block      json.RawMessage `json:"block,omitempty"`
+}
+
+const (
+	opPut         = "put"
+	opDelete      = "delete"
+	opPutPromo    = "put_promo"
+	opPutBlock    = "put_block"
+	opDeleteBlock = "delete_block"
+)
+
+// fileStore is a BookingStore, PromoStore and SeatBlockStore backed by an
+// append-only log of JSON records. Every change is appended and synced before it becomes
+// visible, and the log is replayed into an in-memory index when the store is
+// opened. On open the replayed state is also written out as a fresh log so
+// it does not grow without bound across restarts.
+type fileStore struct {
+	*memoryStore
+
+	mu   sync.Mutex
+	path string
+	file *os.File
+}
+
+// openFileStore replays the log at path, creating it if needed, and returns
+// a store that appends to it.
+func openFileStore(path string) (*fileStore, error) {
+	mem := newMemoryStore()
+
+	if err := replayLog(path, mem); err != nil {
+		return nil, err
+	}
+	if err := compactLog(path, mem); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open booking log: %w", err)
+	}
+
+	return &fileStore{memoryStore: mem, path: path, file: file}, nil
+}
+
+// Helper function to apply every record in the log at path to mem. A
+// partially written last line, left behind by a crash mid-append, is ignored.
+func replayLog(path string, mem *memoryStore) error {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("open booking log: %w", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read booking log: %w", err)
+		}
+
+		var record logRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return fmt.Errorf("booking log line %d: %w", lineNumber, err)
+		}
+
+		switch record.Op {
+		case opPut:
+			receipt := &pb.Receipt{}
+			if err := protojson.Unmarshal(record.Receipt, receipt); err != nil {
+				return fmt.Errorf("booking log line %d: %w", lineNumber, err)
+			}
+			mem.put(receipt)
+		case opDelete:
+			if record.PurchaseID != "" {
+				mem.delete(record.PurchaseID)
+				break
+			}
+			for _, purchaseID := range mem.emails[record.Email] {
+				mem.delete(purchaseID)
+			}
+		case opPutPromo:
+			promo := &pb.PromoCode{}
+			if err := protojson.Unmarshal(record.Promo, promo); err != nil {
+				return fmt.Errorf("booking log line %d: %w", lineNumber, err)
+			}
+			mem.promos[promo.Code] = promo
+		case opPutBlock, opDeleteBlock:
+			block := &pb.SeatBlock{}
+			if err := protojson.Unmarshal(record.Block, block); err != nil {
+				return fmt.Errorf("booking log line %d: %w", lineNumber, err)
+			}
+			if record.Op == opPutBlock {
+				mem.blocks[blockKey(block)] = block
+			} else {
+				delete(mem.blocks, blockKey(block))
+			}
+		default:
+			return fmt.Errorf("booking log line %d: unknown op %q", lineNumber, record.Op)
+		}
+	}
+}
+
+// Helper function to rewrite the log at path so it holds one put per
+// receipt, promo code and seat block
+func compactLog(path string, mem *memoryStore) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("compact booking log: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	// Write receipts in purchase order, which replaying the log restores
+	purchaseIDs := make([]string, 0, len(mem.receipts))
+	for purchaseID := range mem.receipts {
+		purchaseIDs = append(purchaseIDs, purchaseID)
+	}
+	sort.Slice(purchaseIDs, func(i, j int) bool {
+		return mem.order[purchaseIDs[i]] < mem.order[purchaseIDs[j]]
+	})
+
+	writer := bufio.NewWriter(tmp)
+	for _, purchaseID := range purchaseIDs {
+		receipt := mem.receipts[purchaseID]
+		line, err := encodeRecord(opPut, receipt.PurchaseId, receipt)
+		if err != nil {
+			tmp.Close()
+			return err
+		}
+		writer.Write(line)
+	}
+	for _, promo := range mem.promos {
+		line, err := encodeRecord(opPutPromo, "", promo)
+		if err != nil {
+			tmp.Close()
+			return err
+		}
+		writer.Write(line)
+	}
+	for _, block := range mem.blocks {
+		line, err := encodeRecord(opPutBlock, "", block)
+		if err != nil {
+			tmp.Close()
+			return err
+		}
+		writer.Write(line)
+	}
+	if err := writer.Flush(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("compact booking log: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("compact booking log: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("compact booking log: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("compact booking log: %w", err)
+	}
+
+	// The rename is only durable once the directory holding it is synced
+	dir, err := os.Open(filepath.Dir(path))
+	if err != nil {
+		return fmt.Errorf("compact booking log: %w", err)
+	}
+	defer dir.Close()
+	if err := dir.Sync(); err != nil {
+		return fmt.Errorf("compact booking log: %w", err)
+	}
+	return nil
+}
+
+// Helper function to encode one newline-terminated log record carrying an
+// optional receipt, promo code or seat block
+func encodeRecord(op, purchaseID string, message proto.Message) ([]byte, error) {
+	record := logRecord{Op: op, PurchaseID: purchaseID}
+	if message != nil {
+		raw, err := protojson.Marshal(message)
+		if err != nil {
+			return nil, fmt.Errorf("encode %s record: %w", op, err)
+		}
+		switch op {
+		case opPutPromo:
+			record.Promo = raw
+		case opPutBlock, opDeleteBlock:
+			record.Block = raw
+		default:
+			record.Receipt = raw
+		}
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("encode log record: %w", err)
+	}
+	return append(line, '\n'), nil
+}
+
+// Helper function to durably append one record to the log
+func (f *fileStore) appendRecord(op, purchaseID string, message proto.Message) error {
+	line, err := encodeRecord(op, purchaseID, message)
+	if err != nil {
+		return err
+	}
+	if _, err := f.file.Write(line); err != nil {
+		return fmt.Errorf("append booking log: %w", err)
+	}
+	if err := f.file.Sync(); err != nil {
+		return fmt.Errorf("sync booking log: %w", err)
+	}
+	return nil
+}
+
+func (f *fileStore) PutReceipt(receipt *pb.Receipt) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.appendRecord(opPut, receipt.PurchaseId, receipt); err != nil {
+		return err
+	}
+	return f.memoryStore.PutReceipt(receipt)
+}
+
+func (f *fileStore) DeleteReceipt(purchaseID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.appendRecord(opDelete, purchaseID, nil); err != nil {
+		return err
+	}
+	return f.memoryStore.DeleteReceipt(purchaseID)
+}
+
+func (f *fileStore) PutPromo(promo *pb.PromoCode) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.appendRecord(opPutPromo, "", promo); err != nil {
+		return err
+	}
+	return f.memoryStore.PutPromo(promo)
+}
+
+func (f *fileStore) PutSeatBlock(block *pb.SeatBlock) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.appendRecord(opPutBlock, "", block); err != nil {
+		return err
+	}
+	return f.memoryStore.PutSeatBlock(block)
+}
+
+func (f *fileStore) DeleteSeatBlock(block *pb.SeatBlock) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.appendRecord(opDeleteBlock, "", block); err != nil {
+		return err
+	}
+	return f.memoryStore.DeleteSeatBlock(block)
+}
+
+// Ping checks the log is still open and still at its path, so appends are
+// not going to a file that was moved or deleted.
+func (f *fileStore) Ping() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	open, err := f.file.Stat()
+	if err != nil {
+		return fmt.Errorf("booking log: %w", err)
+	}
+	onDisk, err := os.Stat(f.path)
+	if err != nil {
+		return fmt.Errorf("booking log: %w", err)
+	}
+	if !os.SameFile(open, onDisk) {
+		return fmt.Errorf("booking log %s was replaced", f.path)
+	}
+	return nil
+}
+
+func (f *fileStore) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.file.Close()
+}
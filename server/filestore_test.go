@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+)
+
+func TestFileStoreKeepsPurchaseOrderAcrossReopens(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bookings.log")
+	store, err := openFileStore(path)
+	if err != nil {
+		t.Fatalf("openFileStore: %v", err)
+	}
+
+	// A group booking, listed in the order the passengers were given
+	var want []string
+	for i := 0; i < 20; i++ {
+		receipt := &pb.Receipt{
+			PurchaseId: fmt.Sprintf("purchase-%02d", 19-i),
+			BookingId:  "booking",
+			BookedBy:   "lead@example.com",
+			User:       &pb.User{FirstName: fmt.Sprintf("Passenger%d", i), Email: "lead@example.com"},
+		}
+		if err := store.PutReceipt(receipt); err != nil {
+			t.Fatalf("PutReceipt: %v", err)
+		}
+		want = append(want, receipt.PurchaseId)
+	}
+
+	// Each reopen replays and compacts the log
+	for reopen := 1; reopen <= 3; reopen++ {
+		store.Close()
+		if store, err = openFileStore(path); err != nil {
+			t.Fatalf("reopen %d: %v", reopen, err)
+		}
+		for name, receipts := range map[string][]*pb.Receipt{
+			"ListByBooking": store.ListByBooking("booking"),
+			"ListByEmail":   store.ListByEmail("lead@example.com"),
+		} {
+			if len(receipts) != len(want) {
+				t.Fatalf("reopen %d: %s returned %d receipts, want %d", reopen, name, len(receipts), len(want))
+			}
+			for i, receipt := range receipts {
+				if receipt.PurchaseId != want[i] {
+					t.Fatalf("reopen %d: %s has %s at position %d, want %s", reopen, name, receipt.PurchaseId, i, want[i])
+				}
+			}
+		}
+	}
+	store.Close()
+}
+
+// Helper function to open the booking log at path as a server's store
+func newFileServer(t *testing.T, path string) (*Server, *fileStore) {
+	t.Helper()
+	store, err := openFileStore(path)
+	if err != nil {
+		t.Fatalf("openFileStore: %v", err)
+	}
+	return newServer(store, store, store, defaultCatalog()), store
+}
+
+func TestFileStoreRestoresReceiptsAndSeatsOnRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bookings.log")
+	s, store := newFileServer(t, path)
+	first := seatedInA(t, s, "first@example.com", "London", "Paris")
+	second := seatedInA(t, s, "second@example.com", "London", "Paris")
+	store.Close()
+
+	s, store = newFileServer(t, path)
+	defer store.Close()
+	for purchaseID, want := range map[string]string{first: "A-1", second: "A-2"} {
+		if seat := ticketSeat(t, s, purchaseID); seat != want {
+			t.Fatalf("after restart %s is in seat %s, want %s", purchaseID, seat, want)
+		}
+	}
+
+	// The restored seats are still taken
+	third := seatedInA(t, s, "third@example.com", "London", "Paris")
+	if seat := ticketSeat(t, s, third); seat != "A-3" {
+		t.Fatalf("passenger seated after restart is in seat %s, want A-3", seat)
+	}
+	checkNoDoubleBooking(t, s)
+}
+
+func TestFileStoreKeepsDeletesAcrossReopens(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bookings.log")
+	store, err := openFileStore(path)
+	if err != nil {
+		t.Fatalf("openFileStore: %v", err)
+	}
+	for _, purchaseID := range []string{"kept", "deleted"} {
+		receipt := &pb.Receipt{PurchaseId: purchaseID, BookedBy: "lead@example.com", User: &pb.User{Email: "lead@example.com"}}
+		if err := store.PutReceipt(receipt); err != nil {
+			t.Fatalf("PutReceipt: %v", err)
+		}
+	}
+	if err := store.DeleteReceipt("deleted"); err != nil {
+		t.Fatalf("DeleteReceipt: %v", err)
+	}
+
+	// Once replayed from the appended delete, then from the compacted log
+	for reopen := 1; reopen <= 2; reopen++ {
+		store.Close()
+		if store, err = openFileStore(path); err != nil {
+			t.Fatalf("reopen %d: %v", reopen, err)
+		}
+		if _, ok := store.GetReceipt("deleted"); ok {
+			t.Fatalf("reopen %d: deleted receipt came back", reopen)
+		}
+		if _, ok := store.GetReceipt("kept"); !ok {
+			t.Fatalf("reopen %d: kept receipt is missing", reopen)
+		}
+		if receipts := store.ListByEmail("lead@example.com"); len(receipts) != 1 {
+			t.Fatalf("reopen %d: ListByEmail returned %d receipts, want 1", reopen, len(receipts))
+		}
+	}
+	store.Close()
+}
+
+func TestFileStoreIgnoresTruncatedLastLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bookings.log")
+	store, err := openFileStore(path)
+	if err != nil {
+		t.Fatalf("openFileStore: %v", err)
+	}
+	if err := store.PutReceipt(&pb.Receipt{PurchaseId: "written", User: &pb.User{Email: "a@example.com"}}); err != nil {
+		t.Fatalf("PutReceipt: %v", err)
+	}
+	store.Close()
+
+	// A crash part way through appending the next record
+	line, err := encodeRecord(opPut, "torn", &pb.Receipt{PurchaseId: "torn", User: &pb.User{Email: "b@example.com"}})
+	if err != nil {
+		t.Fatalf("encodeRecord: %v", err)
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		t.Fatalf("open log: %v", err)
+	}
+	if _, err := file.Write(line[:len(line)/2]); err != nil {
+		t.Fatalf("write log: %v", err)
+	}
+	file.Close()
+
+	if store, err = openFileStore(path); err != nil {
+		t.Fatalf("reopen with a truncated last line: %v", err)
+	}
+	if _, ok := store.GetReceipt("written"); !ok {
+		t.Fatal("receipt written before the crash is missing")
+	}
+	if _, ok := store.GetReceipt("torn"); ok {
+		t.Fatal("receipt from the truncated line was restored")
+	}
+
+	// Compacting dropped the torn line, so later appends start cleanly
+	if err := store.PutReceipt(&pb.Receipt{PurchaseId: "after", User: &pb.User{Email: "c@example.com"}}); err != nil {
+		t.Fatalf("PutReceipt: %v", err)
+	}
+	store.Close()
+	if store, err = openFileStore(path); err != nil {
+		t.Fatalf("reopen after appending: %v", err)
+	}
+	defer store.Close()
+	if _, ok := store.GetReceipt("after"); !ok {
+		t.Fatal("receipt appended after the crash is missing")
+	}
+}
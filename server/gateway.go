@@ -0,0 +1,457 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Largest request body the gateway reads
+const maxGatewayBody = 1 << 20
+
+// How long the gateway waits for requests in flight when the server stops
+const gatewayShutdownTimeout = 5 * time.Second
+
+// gatewayRoute maps an HTTP method and path to a TicketService method.
+// Segments of the pattern in braces are request fields taken from the path.
+type gatewayRoute struct {
+	method  string
+	pattern string
+	rpc     protoreflect.Name
+}
+
+// Every TicketService method, as a resource. A request is read from the
+// JSON body, then the query string, then the path, each overriding the one
+// before.
+var gatewayRoutes = []gatewayRoute{
+	{http.MethodPost, "/login", "Login"},
+	{http.MethodGet, "/trains", "ListTrains"},
+	{http.MethodGet, "/journeys", "SearchJourneys"},
+	{http.MethodGet, "/fares", "QuoteFare"},
+	{http.MethodGet, "/seat-map", "GetSeatMap"},
+	{http.MethodGet, "/seat-map/updates", "WatchSeatAvailability"},
+	{http.MethodPost, "/tickets", "PurchaseTicket"},
+	{http.MethodGet, "/tickets", "ListBookings"},
+	{http.MethodGet, "/tickets/{purchase_id}", "ShowReceipt"},
+	{http.MethodDelete, "/tickets/{purchase_id}", "RemoveUser"},
+	{http.MethodPost, "/tickets/{purchase_id}/seat", "AllocateSeat"},
+	{http.MethodPut, "/tickets/{purchase_id}/seat", "ModifySeat"},
+	{http.MethodPost, "/tickets/{purchase_id}/hold", "HoldSeat"},
+	{http.MethodPost, "/holds/{hold_id}/confirm", "ConfirmHold"},
+	{http.MethodPost, "/tickets/{purchase_id}/waitlist", "JoinWaitlist"},
+	{http.MethodGet, "/tickets/{purchase_id}/waitlist", "GetWaitlistPosition"},
+	{http.MethodDelete, "/tickets/{purchase_id}/waitlist", "LeaveWaitlist"},
+	{http.MethodPost, "/tickets/{purchase_id}/cancel", "CancelTicket"},
+	{http.MethodPost, "/tickets/{purchase_id}/check-in", "CheckIn"},
+	{http.MethodPost, "/tickets/{purchase_id}/board", "Board"},
+	{http.MethodPost, "/tickets/{purchase_id_a}/swap", "SwapSeats"},
+	{http.MethodPost, "/bookings/{booking_id}/seats", "AllocateSeat"},
+	{http.MethodGet, "/sections/{section}/passengers", "GetUsersBySection"},
+}
+
+// HTTP headers passed on to the gRPC server as metadata
+var gatewayHeaders = []string{authorizationHeader, idempotencyKeyHeader}
+
+// The HTTP status for each gRPC status code, as google.rpc.Code documents
+var httpStatuses = map[codes.Code]int{
+	codes.OK:                 http.StatusOK,
+	codes.Canceled:           499,
+	codes.Unknown:            http.StatusInternalServerError,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.Aborted:            http.StatusConflict,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Internal:           http.StatusInternalServerError,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DataLoss:           http.StatusInternalServerError,
+}
+
+// gateway serves TicketService as JSON over HTTP. It calls the gRPC server
+// like any other client, so the same sign-in and idempotency rules apply.
+type gateway struct {
+	conn    grpc.ClientConnInterface
+	service protoreflect.ServiceDescriptor
+}
+
+// newGateway returns a gateway calling the server over conn. It fails if a
+// TicketService method has no route, so a new method is not left out.
+func newGateway(conn grpc.ClientConnInterface) (*gateway, error) {
+	service := pb.File_proto_train_proto.Services().ByName("TicketService")
+	routed := make(map[protoreflect.Name]bool)
+	for _, route := range gatewayRoutes {
+		if service.Methods().ByName(route.rpc) == nil {
+			return nil, fmt.Errorf("gateway route %s %s: unknown method %s", route.method, route.pattern, route.rpc)
+		}
+		routed[route.rpc] = true
+	}
+	for i := 0; i < service.Methods().Len(); i++ {
+		if name := service.Methods().Get(i).Name(); !routed[name] {
+			return nil, fmt.Errorf("no gateway route for %s", name)
+		}
+	}
+	return &gateway{conn: conn, service: service}, nil
+}
+
+// serveGateway serves the gateway on addr, calling the gRPC server at
+// grpcAddr, until the returned function is called. With a TLS certificate
+// it serves HTTPS and calls the server over TLS.
+func serveGateway(addr, grpcAddr string, tlsConfig TLSConfig) (stop func(), err error) {
+	creds := insecure.NewCredentials()
+	if tlsConfig.enabled() {
+		clientConfig, err := tlsConfig.clientConfig()
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(clientConfig)
+	}
+	conn, err := grpc.Dial(loopbackAddr(grpcAddr), grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	gw, err := newGateway(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	server := &http.Server{Handler: gw, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		serve := func() error { return server.Serve(lis) }
+		if tlsConfig.enabled() {
+			serve = func() error { return server.ServeTLS(lis, tlsConfig.CertFile, tlsConfig.KeyFile) }
+		}
+		if err := serve(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("failed to serve gateway: %v", err)
+		}
+	}()
+
+	return func() {
+		// Streams never finish on their own, so stop waiting after a while
+		ctx, cancel := context.WithTimeout(context.Background(), gatewayShutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			server.Close()
+		}
+		conn.Close()
+	}, nil
+}
+
+// Helper function to turn the address a server listens on into one to dial
+// it at from the same machine
+func loopbackAddr(addr string) string {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, port)
+}
+
+func (g *gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	segments := strings.Split(strings.Trim(r.URL.EscapedPath(), "/"), "/")
+	var allowed []string
+	for _, route := range gatewayRoutes {
+		params, ok := route.match(segments)
+		if !ok {
+			continue
+		}
+		if route.method != r.Method {
+			allowed = append(allowed, route.method)
+			continue
+		}
+		g.serve(w, r, route, params)
+		return
+	}
+
+	if len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		writeGatewayError(w, http.StatusMethodNotAllowed, status.New(codes.Unimplemented, fmt.Sprintf("Method %s is not allowed on %s", r.Method, r.URL.Path)))
+		return
+	}
+	writeGatewayError(w, http.StatusNotFound, status.New(codes.NotFound, fmt.Sprintf("Unknown resource: %s", r.URL.Path)))
+}
+
+// Helper function to match the segments of a path against a route,
+// returning the request fields named in it
+func (route gatewayRoute) match(segments []string) (map[string]string, bool) {
+	pattern := strings.Split(strings.Trim(route.pattern, "/"), "/")
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, part := range pattern {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			value, err := url.PathUnescape(segments[i])
+			if err != nil || value == "" {
+				return nil, false
+			}
+			params[part[1:len(part)-1]] = value
+		} else if part != segments[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// serve makes the call of a route and writes its response.
+func (g *gateway) serve(w http.ResponseWriter, r *http.Request, route gatewayRoute, params map[string]string) {
+	method := g.service.Methods().ByName(route.rpc)
+	req, err := newMessage(method.Input())
+	if err != nil {
+		writeGatewayError(w, http.StatusInternalServerError, status.New(codes.Internal, err.Error()))
+		return
+	}
+
+	// Read the request from the body, query string and path
+	if r.Method == http.MethodPost || r.Method == http.MethodPut {
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxGatewayBody))
+		if err != nil {
+			writeGatewayError(w, http.StatusRequestEntityTooLarge, status.New(codes.InvalidArgument, fmt.Sprintf("Invalid request body: %v", err)))
+			return
+		}
+		if len(bytes.TrimSpace(body)) > 0 {
+			if err := protojson.Unmarshal(body, req); err != nil {
+				writeGatewayStatus(w, status.New(codes.InvalidArgument, fmt.Sprintf("Invalid request body: %v", err)))
+				return
+			}
+		}
+	}
+	for name, values := range r.URL.Query() {
+		for _, value := range values {
+			if err := setField(req.ProtoReflect(), name, value); err != nil {
+				writeGatewayStatus(w, status.New(codes.InvalidArgument, fmt.Sprintf("Invalid query parameter %s: %v", name, err)))
+				return
+			}
+		}
+	}
+	for name, value := range params {
+		if err := setField(req.ProtoReflect(), name, value); err != nil {
+			writeGatewayError(w, http.StatusInternalServerError, status.New(codes.Internal, err.Error()))
+			return
+		}
+	}
+
+	// Pass on the caller's token and idempotency key
+	md := metadata.MD{}
+	for _, name := range gatewayHeaders {
+		if values := r.Header.Values(name); len(values) > 0 {
+			md.Set(name, values...)
+		}
+	}
+	ctx := metadata.NewOutgoingContext(r.Context(), md)
+	fullMethod := fmt.Sprintf("/%s/%s", g.service.FullName(), method.Name())
+
+	if method.IsStreamingServer() {
+		g.stream(ctx, w, fullMethod, method, req)
+		return
+	}
+
+	resp, err := newMessage(method.Output())
+	if err != nil {
+		writeGatewayError(w, http.StatusInternalServerError, status.New(codes.Internal, err.Error()))
+		return
+	}
+	if err := g.conn.Invoke(ctx, fullMethod, req, resp); err != nil {
+		writeGatewayStatus(w, status.Convert(err))
+		return
+	}
+	encoded, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(resp)
+	if err != nil {
+		writeGatewayError(w, http.StatusInternalServerError, status.New(codes.Internal, err.Error()))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(encoded)
+}
+
+// stream writes the messages of a server stream as lines of JSON until it
+// ends or the caller goes away. An error after the first message can no
+// longer change the HTTP status, so it is sent as a last line
+// {"error": {...}}.
+func (g *gateway) stream(ctx context.Context, w http.ResponseWriter, fullMethod string, method protoreflect.MethodDescriptor, req proto.Message) {
+	stream, err := g.conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, fullMethod)
+	if err == nil {
+		err = stream.SendMsg(req)
+	}
+	if err == nil {
+		err = stream.CloseSend()
+	}
+	if err != nil {
+		writeGatewayStatus(w, status.Convert(err))
+		return
+	}
+
+	flusher, _ := w.(http.Flusher)
+	for started := false; ; started = true {
+		message, err := newMessage(method.Output())
+		if err == nil {
+			err = stream.RecvMsg(message)
+		}
+		if err == io.EOF || ctx.Err() != nil {
+			return
+		}
+		if err != nil && !started {
+			writeGatewayStatus(w, status.Convert(err))
+			return
+		}
+		if err != nil {
+			encoded, _ := protojson.Marshal(status.Convert(err).Proto())
+			fmt.Fprintf(w, "{\"error\":%s}\n", encoded)
+			return
+		}
+
+		if !started {
+			w.Header().Set("Content-Type", "application/x-ndjson")
+		}
+		encoded, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+		if err != nil {
+			return
+		}
+		w.Write(append(encoded, '\n'))
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+// Helper function to make an empty message of a type
+func newMessage(md protoreflect.MessageDescriptor) (proto.Message, error) {
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+	if err != nil {
+		return nil, err
+	}
+	return messageType.New().Interface(), nil
+}
+
+// Helper function to write a failed call with the HTTP status for its code
+func writeGatewayStatus(w http.ResponseWriter, st *status.Status) {
+	httpStatus, ok := httpStatuses[st.Code()]
+	if !ok {
+		httpStatus = http.StatusInternalServerError
+	}
+	writeGatewayError(w, httpStatus, st)
+}
+
+// Helper function to write an error as a google.rpc.Status in JSON
+func writeGatewayError(w http.ResponseWriter, httpStatus int, st *status.Status) {
+	encoded, err := protojson.Marshal(st.Proto())
+	if err != nil {
+		encoded = []byte(`{"code":13,"message":"Failed to encode error"}`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	w.Write(encoded)
+}
+
+// setField sets a field of a request from text, as found in a query string
+// or path. Nested fields are named with dots, e.g. preferences.position, and
+// a repeated field gets a value appended each time.
+func setField(m protoreflect.Message, path, value string) error {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fields := m.Descriptor().Fields()
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = fields.ByJSONName(name)
+		}
+		if fd == nil {
+			return fmt.Errorf("unknown field %q", name)
+		}
+		if i < len(names)-1 {
+			if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+				return fmt.Errorf("field %q has no fields", name)
+			}
+			m = m.Mutable(fd).Message()
+			continue
+		}
+
+		if fd.Message() != nil || fd.IsMap() {
+			return fmt.Errorf("field %q must be sent in the body", name)
+		}
+		v, err := parseScalar(fd, value)
+		if err != nil {
+			return err
+		}
+		if fd.IsList() {
+			m.Mutable(fd).List().Append(v)
+		} else {
+			m.Set(fd, v)
+		}
+	}
+	return nil
+}
+
+// Helper function to parse the text of a scalar field
+func parseScalar(fd protoreflect.FieldDescriptor, value string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(value), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(value)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(value, 10, 32)
+		return protoreflect.ValueOfInt32(int32(n)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(value, 10, 64)
+		return protoreflect.ValueOfInt64(n), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(value, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(n)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(value, 10, 64)
+		return protoreflect.ValueOfUint64(n), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(value, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(value, 64)
+		return protoreflect.ValueOfFloat64(f), err
+	case protoreflect.EnumKind:
+		if v := fd.Enum().Values().ByName(protoreflect.Name(value)); v != nil {
+			return protoreflect.ValueOfEnum(v.Number()), nil
+		}
+		n, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || fd.Enum().Values().ByNumber(protoreflect.EnumNumber(n)) == nil {
+			return protoreflect.Value{}, fmt.Errorf("invalid value %q for %s", value, fd.Enum().Name())
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("field %q must be sent in the body", fd.Name())
+	}
+}
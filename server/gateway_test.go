@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// recordingConn stands in for the gRPC server behind the gateway. It keeps
+// the last call made and answers it with reply, or fails it with err.
+type recordingConn struct {
+	method string
+	req    proto.Message
+	md     metadata.MD
+	reply  proto.Message
+	err    error
+}
+
+func (c *recordingConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	c.method = method
+	c.req = proto.Clone(args.(proto.Message))
+	c.md, _ = metadata.FromOutgoingContext(ctx)
+	if c.err != nil {
+		return c.err
+	}
+	if c.reply != nil {
+		proto.Merge(reply.(proto.Message), c.reply)
+	}
+	return nil
+}
+
+func (c *recordingConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	c.method = method
+	if c.err != nil {
+		return nil, c.err
+	}
+	return nil, status.Error(codes.Unimplemented, "streams are not recorded")
+}
+
+// Helper function to make a gateway calling conn
+func newTestGateway(t *testing.T, conn *recordingConn) *gateway {
+	t.Helper()
+	gw, err := newGateway(conn)
+	if err != nil {
+		t.Fatalf("newGateway: %v", err)
+	}
+	return gw
+}
+
+// Helper function to send a request through the gateway
+func gatewayRequest(gw *gateway, method, target, body string, header http.Header) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	for name, values := range header {
+		r.Header[name] = values
+	}
+	w := httptest.NewRecorder()
+	gw.ServeHTTP(w, r)
+	return w
+}
+
+// Helper function to read the google.rpc.Status the gateway wrote
+func gatewayErrorCode(t *testing.T, w *httptest.ResponseRecorder) codes.Code {
+	t.Helper()
+	var body struct {
+		Code    uint32 `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("error body %q is not JSON: %v", w.Body.String(), err)
+	}
+	if body.Message == "" {
+		t.Fatalf("error body %q has no message", w.Body.String())
+	}
+	return codes.Code(body.Code)
+}
+
+func TestGatewayMatchesRoutes(t *testing.T) {
+	tests := []struct {
+		method, target string
+		wantMethod     string
+		wantStatus     int
+		wantAllow      string
+	}{
+		{http.MethodPost, "/login", "Login", http.StatusOK, ""},
+		{http.MethodGet, "/tickets", "ListBookings", http.StatusOK, ""},
+		{http.MethodPost, "/tickets", "PurchaseTicket", http.StatusOK, ""},
+		{http.MethodGet, "/tickets/p1", "ShowReceipt", http.StatusOK, ""},
+		{http.MethodDelete, "/tickets/p1/", "RemoveUser", http.StatusOK, ""},
+		{http.MethodPut, "/tickets/p1/seat", "ModifySeat", http.StatusOK, ""},
+		{http.MethodPost, "/tickets/p1/seat", "AllocateSeat", http.StatusOK, ""},
+		{http.MethodPost, "/bookings/b1/seats", "AllocateSeat", http.StatusOK, ""},
+		{http.MethodGet, "/sections/A/passengers", "GetUsersBySection", http.StatusOK, ""},
+		{http.MethodPatch, "/tickets/p1", "", http.StatusMethodNotAllowed, "GET, DELETE"},
+		{http.MethodGet, "/tickets/p1/nothing", "", http.StatusNotFound, ""},
+		{http.MethodGet, "/tickets/p1/seat/extra", "", http.StatusNotFound, ""},
+		{http.MethodGet, "/", "", http.StatusNotFound, ""},
+	}
+	for _, tt := range tests {
+		conn := &recordingConn{}
+		w := gatewayRequest(newTestGateway(t, conn), tt.method, tt.target, "", nil)
+
+		if w.Code != tt.wantStatus {
+			t.Errorf("%s %s: got status %d, want %d", tt.method, tt.target, w.Code, tt.wantStatus)
+			continue
+		}
+		want := ""
+		if tt.wantMethod != "" {
+			want = "/ticket_service.TicketService/" + tt.wantMethod
+		}
+		if conn.method != want {
+			t.Errorf("%s %s: called %q, want %q", tt.method, tt.target, conn.method, want)
+		}
+		if got := w.Header().Get("Allow"); got != tt.wantAllow {
+			t.Errorf("%s %s: got Allow %q, want %q", tt.method, tt.target, got, tt.wantAllow)
+		}
+	}
+}
+
+func TestGatewayBindsBodyQueryAndPath(t *testing.T) {
+	conn := &recordingConn{reply: &pb.ModifySeatResponse{Res: "Seat changed"}}
+	gw := newTestGateway(t, conn)
+
+	// The query overrides the body and the path overrides both
+	header := http.Header{"Authorization": {"Bearer token"}, "Idempotency-Key": {"key-1"}}
+	w := gatewayRequest(gw, http.MethodPut, "/tickets/p%2F1/seat?new_seat_number=4&purchase_id=query",
+		`{"purchase_id":"body","new_section":"B","new_seat_number":3}`, header)
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d, want 200: %s", w.Code, w.Body.String())
+	}
+	want := &pb.ModifySeatRequest{PurchaseId: "p/1", NewSection: "B", NewSeatNumber: 4}
+	if !proto.Equal(conn.req, want) {
+		t.Fatalf("got request %v, want %v", conn.req, want)
+	}
+	if got := conn.md.Get(authorizationHeader); len(got) != 1 || got[0] != "Bearer token" {
+		t.Fatalf("got authorization metadata %q, want the header", got)
+	}
+	if got := conn.md.Get(idempotencyKeyHeader); len(got) != 1 || got[0] != "key-1" {
+		t.Fatalf("got idempotency key metadata %q, want the header", got)
+	}
+
+	// The response is JSON with the proto field names
+	if got := w.Header().Get("Content-Type"); got != "application/json" {
+		t.Fatalf("got content type %q, want application/json", got)
+	}
+	var response map[string]string
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil || response["res"] != "Seat changed" {
+		t.Fatalf("got response %q, want {\"res\": \"Seat changed\"}", w.Body.String())
+	}
+}
+
+func TestGatewayBindsNestedAndEnumQueryFields(t *testing.T) {
+	conn := &recordingConn{}
+	gw := newTestGateway(t, conn)
+
+	w := gatewayRequest(gw, http.MethodPost, "/bookings/b1/seats?contiguous=true&preferences.position=SEAT_POSITION_WINDOW&preferences.nearExit=1", "", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d, want 200: %s", w.Code, w.Body.String())
+	}
+	want := &pb.AllocateSeatRequest{
+		BookingId:  "b1",
+		Contiguous: true,
+		Preferences: &pb.SeatPreferences{
+			Position: pb.SeatPosition_SEAT_POSITION_WINDOW,
+			NearExit: true,
+		},
+	}
+	if !proto.Equal(conn.req, want) {
+		t.Fatalf("got request %v, want %v", conn.req, want)
+	}
+}
+
+func TestGatewayRejectsBadRequests(t *testing.T) {
+	tests := []struct {
+		name, method, target, body string
+	}{
+		{"unknown query field", http.MethodGet, "/tickets/p1?colour=red", ""},
+		{"bad number", http.MethodPut, "/tickets/p1/seat?new_seat_number=front", ""},
+		{"bad enum", http.MethodPost, "/tickets/p1/seat?preferences.position=MIDDLE", ""},
+		{"message in query", http.MethodPost, "/tickets/p1/seat?preferences=window", ""},
+		{"bad body", http.MethodPost, "/login", `{"email":`},
+		{"unknown body field", http.MethodPost, "/login", `{"colour":"red"}`},
+	}
+	for _, tt := range tests {
+		conn := &recordingConn{}
+		w := gatewayRequest(newTestGateway(t, conn), tt.method, tt.target, tt.body, nil)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: got status %d, want 400", tt.name, w.Code)
+			continue
+		}
+		if code := gatewayErrorCode(t, w); code != codes.InvalidArgument {
+			t.Errorf("%s: got code %v, want InvalidArgument", tt.name, code)
+		}
+		if conn.method != "" {
+			t.Errorf("%s: the gateway called %s", tt.name, conn.method)
+		}
+	}
+}
+
+func TestGatewayMapsStatusCodes(t *testing.T) {
+	tests := []struct {
+		code       codes.Code
+		wantStatus int
+	}{
+		{codes.InvalidArgument, http.StatusBadRequest},
+		{codes.FailedPrecondition, http.StatusBadRequest},
+		{codes.Unauthenticated, http.StatusUnauthorized},
+		{codes.PermissionDenied, http.StatusForbidden},
+		{codes.NotFound, http.StatusNotFound},
+		{codes.AlreadyExists, http.StatusConflict},
+		{codes.Aborted, http.StatusConflict},
+		{codes.ResourceExhausted, http.StatusTooManyRequests},
+		{codes.Canceled, 499},
+		{codes.Internal, http.StatusInternalServerError},
+		{codes.Unimplemented, http.StatusNotImplemented},
+		{codes.Unavailable, http.StatusServiceUnavailable},
+		{codes.DeadlineExceeded, http.StatusGatewayTimeout},
+		{codes.Code(99), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		conn := &recordingConn{err: status.Error(tt.code, "call failed")}
+		gw := newTestGateway(t, conn)
+
+		for _, target := range []string{"/tickets/p1", "/seat-map/updates"} {
+			w := gatewayRequest(gw, http.MethodGet, target, "", nil)
+			if w.Code != tt.wantStatus {
+				t.Errorf("GET %s failing with %v: got status %d, want %d", target, tt.code, w.Code, tt.wantStatus)
+				continue
+			}
+			if code := gatewayErrorCode(t, w); code != tt.code {
+				t.Errorf("GET %s failing with %v: got code %v in the body", target, tt.code, code)
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// How often readiness is checked
+const healthCheckInterval = 5 * time.Second
+
+// Services whose health is reported, besides the server as a whole ("")
+var healthServices = []string{
+	"ticket_service.TicketService",
+	"ticket_service.TicketAdminService",
+}
+
+// ready reports why the server cannot take calls, or nil if it can: the
+// catalog must have trains to sell and the booking store must be able to
+// save changes.
+func (s *Server) ready() error {
+	if s.catalog == nil || len(s.catalog.Trains) == 0 {
+		return errors.New("catalog has no trains")
+	}
+	if err := s.store.Ping(); err != nil {
+		return fmt.Errorf("booking store: %w", err)
+	}
+	return nil
+}
+
+// startHealthChecks keeps the status reported by healthServer up to date,
+// checking readiness every interval until the returned function is called.
+// Once the server is draining, healthServer.Shutdown reports every service
+// as not serving and the checks no longer change it.
+func (s *Server) startHealthChecks(healthServer *health.Server, interval time.Duration) (stop func()) {
+	var last error
+	first := true
+	check := func() {
+		err := s.ready()
+		servingStatus := healthpb.HealthCheckResponse_SERVING
+		if err != nil {
+			servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		for _, service := range append([]string{""}, healthServices...) {
+			healthServer.SetServingStatus(service, servingStatus)
+		}
+
+		// Log changes only
+		switch {
+		case err != nil && (first || last == nil || err.Error() != last.Error()):
+			log.Printf("health: not serving: %v", err)
+		case err == nil && !first && last != nil:
+			log.Printf("health: serving again")
+		}
+		last, first = err, false
+	}
+	check()
+
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				check()
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		ticker.Stop()
+		close(done)
+	}
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Helper function to read the status health reports for every service
+func servingStatuses(t *testing.T, healthServer *health.Server) map[string]healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	statuses := make(map[string]healthpb.HealthCheckResponse_ServingStatus)
+	for _, service := range append([]string{""}, healthServices...) {
+		response, err := healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatalf("Check(%q): %v", service, err)
+		}
+		statuses[service] = response.Status
+	}
+	return statuses
+}
+
+func TestHealthReportsReadiness(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(t *testing.T) *Server
+		want  healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{"memory store", func(t *testing.T) *Server {
+			return newTestServer(t)
+		}, healthpb.HealthCheckResponse_SERVING},
+		{"no trains", func(t *testing.T) *Server {
+			s := newTestServer(t)
+			s.catalog = &Catalog{}
+			return s
+		}, healthpb.HealthCheckResponse_NOT_SERVING},
+		{"file store", func(t *testing.T) *Server {
+			s, store := newFileServer(t, filepath.Join(t.TempDir(), "bookings.log"))
+			t.Cleanup(func() { store.Close() })
+			return s
+		}, healthpb.HealthCheckResponse_SERVING},
+		{"log deleted", func(t *testing.T) *Server {
+			path := filepath.Join(t.TempDir(), "bookings.log")
+			s, store := newFileServer(t, path)
+			t.Cleanup(func() { store.Close() })
+			if err := os.Remove(path); err != nil {
+				t.Fatalf("remove log: %v", err)
+			}
+			return s
+		}, healthpb.HealthCheckResponse_NOT_SERVING},
+		{"log replaced", func(t *testing.T) *Server {
+			path := filepath.Join(t.TempDir(), "bookings.log")
+			s, store := newFileServer(t, path)
+			t.Cleanup(func() { store.Close() })
+			if err := os.Rename(path, path+".old"); err != nil {
+				t.Fatalf("move log: %v", err)
+			}
+			if err := os.WriteFile(path, nil, 0o644); err != nil {
+				t.Fatalf("replace log: %v", err)
+			}
+			return s
+		}, healthpb.HealthCheckResponse_NOT_SERVING},
+		{"log closed", func(t *testing.T) *Server {
+			s, store := newFileServer(t, filepath.Join(t.TempDir(), "bookings.log"))
+			store.Close()
+			return s
+		}, healthpb.HealthCheckResponse_NOT_SERVING},
+	}
+	for _, tt := range tests {
+		s := tt.setup(t)
+		healthServer := health.NewServer()
+		stop := s.startHealthChecks(healthServer, time.Hour)
+		for service, got := range servingStatuses(t, healthServer) {
+			if got != tt.want {
+				t.Errorf("%s: service %q is %v, want %v", tt.name, service, got, tt.want)
+			}
+		}
+		stop()
+	}
+}
+
+func TestHealthFollowsReadinessUntilShutdown(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bookings.log")
+	s, store := newFileServer(t, path)
+	defer store.Close()
+	healthServer := health.NewServer()
+	stop := s.startHealthChecks(healthServer, time.Millisecond)
+	defer stop()
+
+	// Waits for every service to report want
+	waitFor := func(want healthpb.HealthCheckResponse_ServingStatus) {
+		t.Helper()
+		deadline := time.Now().Add(time.Second)
+		for {
+			statuses := servingStatuses(t, healthServer)
+			reached := true
+			for _, got := range statuses {
+				reached = reached && got == want
+			}
+			if reached {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("services report %v, want all %v", statuses, want)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	// The log moved away and back again
+	waitFor(healthpb.HealthCheckResponse_SERVING)
+	if err := os.Rename(path, path+".moved"); err != nil {
+		t.Fatalf("move log: %v", err)
+	}
+	waitFor(healthpb.HealthCheckResponse_NOT_SERVING)
+	if err := os.Rename(path+".moved", path); err != nil {
+		t.Fatalf("restore log: %v", err)
+	}
+	waitFor(healthpb.HealthCheckResponse_SERVING)
+
+	// Draining wins over the checks that keep running
+	healthServer.Shutdown()
+	time.Sleep(10 * time.Millisecond)
+	for service, got := range servingStatuses(t, healthServer) {
+		if got != healthpb.HealthCheckResponse_NOT_SERVING {
+			t.Fatalf("after shutdown service %q is %v, want NOT_SERVING", service, got)
+		}
+	}
+}
@@ -0,0 +1,306 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// How long a held seat stays reserved unless configured otherwise
+const defaultHoldTTL = 10 * time.Minute
+
+// How often the reaper looks for expired holds
+const holdReapInterval = time.Second
+
+// seatHold reserves a seat on one leg of a departure for a ticket until it
+// expires. Holds live only in memory: a restart releases every held seat,
+// which is no worse than the hold expiring.
+type seatHold struct {
+	id         string
+	purchaseID string
+	seat       seatKey
+	leg        Leg
+	expires    time.Time
+}
+
+// holdTable indexes the active holds by ID, by ticket and by seat. A ticket
+// has at most one hold at a time. Callers must hold s.mu.
+type holdTable struct {
+	byID       map[string]*seatHold
+	byPurchase map[string]*seatHold
+	bySeat     map[seatKey][]*seatHold
+}
+
+func newHoldTable() *holdTable {
+	return &holdTable{
+		byID:       make(map[string]*seatHold),
+		byPurchase: make(map[string]*seatHold),
+		bySeat:     make(map[seatKey][]*seatHold),
+	}
+}
+
+func (h *holdTable) add(hold *seatHold) {
+	h.byID[hold.id] = hold
+	h.byPurchase[hold.purchaseID] = hold
+	h.bySeat[hold.seat] = append(h.bySeat[hold.seat], hold)
+}
+
+func (h *holdTable) remove(hold *seatHold) {
+	delete(h.byID, hold.id)
+	if h.byPurchase[hold.purchaseID] == hold {
+		delete(h.byPurchase, hold.purchaseID)
+	}
+	var kept []*seatHold
+	for _, other := range h.bySeat[hold.seat] {
+		if other != hold {
+			kept = append(kept, other)
+		}
+	}
+	if len(kept) == 0 {
+		delete(h.bySeat, hold.seat)
+	} else {
+		h.bySeat[hold.seat] = kept
+	}
+}
+
+// Helper function to check whether an unexpired hold covers a seat on any
+// part of leg. Callers must hold s.mu.
+func (s *Server) seatHeld(seat seatKey, leg Leg) bool {
+	now := s.now()
+	for _, hold := range s.holds.bySeat[seat] {
+		if now.Before(hold.expires) && hold.leg.Overlaps(leg) {
+			return true
+		}
+	}
+	return false
+}
+
+// Helper function to drop a hold and publish why. Callers must hold s.mu
+// for writing.
+func (s *Server) dropHold(hold *seatHold, kind string) {
+	s.holds.remove(hold)
+	s.emit(seatEvent{
+		kind:       kind,
+		departure:  hold.seat.departure,
+		section:    hold.seat.section,
+		seatNumber: hold.seat.seatNumber,
+	})
+}
+
+// Helper function to drop the hold of a ticket, if it has one.
+// Callers must hold s.mu for writing.
+func (s *Server) releaseHold(purchaseID string) {
+	if hold, ok := s.holds.byPurchase[purchaseID]; ok {
+		s.dropHold(hold, eventHoldReleased)
+	}
+}
+
+// Helper function to run check with the hold of a ticket set aside, so the
+// seat it holds counts as free. The hold is released if check succeeds and
+// kept if it fails, so a rejected request for a new seat does not lose the
+// old one. Callers must hold s.mu for writing.
+func (s *Server) replaceHold(purchaseID string, check func() error) error {
+	hold, ok := s.holds.byPurchase[purchaseID]
+	if !ok {
+		return check()
+	}
+	s.holds.remove(hold)
+	if err := check(); err != nil {
+		s.holds.add(hold)
+		return err
+	}
+	s.dropHold(hold, eventHoldReleased)
+	return nil
+}
+
+// releaseExpiredHolds drops every hold that has expired by the server clock,
+// offers the released seats to the waitlist and returns how many it released.
+func (s *Server) releaseExpiredHolds() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	released := 0
+	departures := make(map[Departure]bool)
+	for _, hold := range s.holds.byID {
+		if !now.Before(hold.expires) {
+			s.dropHold(hold, eventHoldReleased)
+			departures[hold.seat.departure] = true
+			released++
+		}
+	}
+	for departure := range departures {
+		s.serveWaitlist(departure)
+	}
+	return released
+}
+
+// startHoldReaper releases expired holds every interval until the returned
+// function is called.
+func (s *Server) startHoldReaper(interval time.Duration) (stop func()) {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				s.releaseExpiredHolds()
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		ticker.Stop()
+		close(done)
+	}
+}
+
+// Helper function to describe a hold to a client
+func holdInfo(hold *seatHold) *pb.SeatHold {
+	return &pb.SeatHold{
+		HoldId:     hold.id,
+		PurchaseId: hold.purchaseID,
+		Seat:       &pb.Seat{Section: hold.seat.section, SeatNumber: hold.seat.seatNumber},
+		ExpiresAt:  hold.expires.Format(time.RFC3339),
+	}
+}
+
+func (s *Server) HoldSeat(ctx context.Context, req *pb.HoldSeatRequest) (*pb.HoldSeatResponse, error) {
+	// Validate the request
+	if req == nil || req.PurchaseId == "" || req.Section == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Purchase id and section cannot be empty")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	receipt, err := s.lookupOwnTicket(ctx, req.PurchaseId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the seat is already allocated for the user; seats can be
+	// held while payment is still pending
+	if receipt.Status == pb.TicketStatus_TICKET_STATUS_SEATED {
+		return nil, status.Errorf(codes.FailedPrecondition, "Seat already allocated for purchase %s", receipt.PurchaseId)
+	}
+	if receipt.Status != pb.TicketStatus_TICKET_STATUS_PENDING_PAYMENT {
+		if err := checkTransition(receipt, pb.TicketStatus_TICKET_STATUS_SEATED, "hold a seat"); err != nil {
+			return nil, err
+		}
+	}
+
+	train, err := s.receiptTrain(receipt)
+	if err != nil {
+		return nil, err
+	}
+
+	section := train.Section(req.Section)
+	if section == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid section: %s (available: %s)", req.Section, train.SectionNames())
+	}
+
+	if err := checkSeatClass(receipt, section); err != nil {
+		return nil, err
+	}
+
+	// A new hold replaces any earlier one for the same ticket
+	departure := receiptDeparture(receipt)
+	leg := receiptLeg(train, receipt)
+	seatNumber := req.SeatNumber
+	err = s.replaceHold(receipt.PurchaseId, func() error {
+		if seatNumber == 0 {
+			var available bool
+			seatNumber, available = s.findNextAvailableSeat(train, departure, section, leg)
+			if !available {
+				return status.Errorf(codes.ResourceExhausted, "No more seats available in section %s", req.Section)
+			}
+			return nil
+		}
+		if !section.HasSeat(seatNumber) {
+			return status.Errorf(codes.InvalidArgument, "Invalid seat number. Must be between 1 and %d", section.Capacity)
+		}
+		if !s.seatFree(train, departure, section.Name, seatNumber, leg) {
+			return status.Errorf(codes.ResourceExhausted, "Requested seat is not available in the specified section")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hold := &seatHold{
+		id:         uuid.New().String(),
+		purchaseID: receipt.PurchaseId,
+		seat:       seatKey{departure: departure, section: section.Name, seatNumber: seatNumber},
+		leg:        leg,
+		expires:    s.now().Add(s.holdTTL),
+	}
+	s.holds.add(hold)
+	s.emit(seatEvent{
+		kind:       eventSeatHeld,
+		departure:  departure,
+		section:    section.Name,
+		seatNumber: seatNumber,
+	})
+
+	return &pb.HoldSeatResponse{Hold: holdInfo(hold)}, nil
+}
+
+func (s *Server) ConfirmHold(ctx context.Context, req *pb.ConfirmHoldRequest) (*pb.ConfirmHoldResponse, error) {
+	// Validate the request
+	if req == nil || req.HoldId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Hold id cannot be empty")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hold, exists := s.holds.byID[req.HoldId]
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "Hold not found or already released: %s", req.HoldId)
+	}
+	if !s.now().Before(hold.expires) {
+		s.dropHold(hold, eventHoldReleased)
+		return nil, status.Errorf(codes.FailedPrecondition, "Hold %s expired at %s", hold.id, hold.expires.Format(time.RFC3339))
+	}
+
+	receipt, exists := s.store.GetReceipt(hold.purchaseID)
+	if !exists {
+		s.dropHold(hold, eventHoldReleased)
+		return nil, status.Errorf(codes.NotFound, "Purchase not found: %s", hold.purchaseID)
+	}
+	if err := s.authorizeTicket(ctx, receipt); err != nil {
+		return nil, err
+	}
+	s.refreshStatus(receipt)
+	if err := checkTransition(receipt, pb.TicketStatus_TICKET_STATUS_SEATED, "confirm the hold"); err != nil {
+		return nil, err
+	}
+
+	// An admin may have blocked the seat since it was held
+	if s.blocks.SeatBlocked(hold.seat.departure, hold.seat.section, hold.seat.seatNumber) {
+		s.dropHold(hold, eventHoldReleased)
+		return nil, status.Errorf(codes.FailedPrecondition, "Seat %s-%d was blocked after it was held; hold another seat", hold.seat.section, hold.seat.seatNumber)
+	}
+
+	// Turn the hold into an allocation
+	receipt.Seat = &pb.Seat{Section: hold.seat.section, SeatNumber: hold.seat.seatNumber}
+	receipt.Waitlist = nil
+	receipt.Status = pb.TicketStatus_TICKET_STATUS_SEATED
+	if err := s.dropSwapRequests(receipt); err != nil {
+		return nil, storeError(err)
+	}
+	if err := s.store.PutReceipt(receipt); err != nil {
+		return nil, storeError(err)
+	}
+	s.holds.remove(hold)
+	s.emitSeat(eventSeatAllocated, receipt)
+
+	return &pb.ConfirmHoldResponse{Receipt: receipt}, nil
+}
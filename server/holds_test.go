@@ -0,0 +1,153 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeClock is a server clock that only moves when told to.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+// Helper function to make a server on a fake clock with every seat of
+// section A but the last taken, and a hold on that last seat. It returns
+// the hold.
+func newHeldSeatServer(t *testing.T) (*Server, *fakeClock, *pb.SeatHold) {
+	t.Helper()
+	s := newTestServer(t)
+	clock := &fakeClock{now: time.Now()}
+	s.now = clock.Now
+	ctx := adminContext()
+
+	for i := 0; i < 9; i++ {
+		purchaseID := purchaseTicket(t, s, fmt.Sprintf("seated%d@example.com", i), "London", "Paris").PurchaseId
+		if _, err := s.AllocateSeat(ctx, &pb.AllocateSeatRequest{PurchaseId: purchaseID, Section: "A"}); err != nil {
+			t.Fatalf("AllocateSeat: %v", err)
+		}
+	}
+
+	holder := purchaseTicket(t, s, "holder@example.com", "London", "Paris")
+	held, err := s.HoldSeat(ctx, &pb.HoldSeatRequest{PurchaseId: holder.PurchaseId, Section: "A", SeatNumber: 10})
+	if err != nil {
+		t.Fatalf("HoldSeat: %v", err)
+	}
+	return s, clock, held.Hold
+}
+
+func TestExpiredHoldCannotBeConfirmed(t *testing.T) {
+	s, clock, hold := newHeldSeatServer(t)
+
+	clock.Advance(s.holdTTL)
+	_, err := s.ConfirmHold(adminContext(), &pb.ConfirmHoldRequest{HoldId: hold.HoldId})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("ConfirmHold after the hold expired: got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestReaperGivesExpiredHoldToWaitlist(t *testing.T) {
+	s, clock, hold := newHeldSeatServer(t)
+	ctx := adminContext()
+
+	// The held seat is the last one, so the next buyer has to wait
+	waiting := purchaseTicket(t, s, "waiting@example.com", "London", "Paris")
+	if _, err := s.JoinWaitlist(ctx, &pb.JoinWaitlistRequest{PurchaseId: waiting.PurchaseId, Section: "A"}); err != nil {
+		t.Fatalf("JoinWaitlist: %v", err)
+	}
+
+	// Nothing is released before the hold expires
+	clock.Advance(s.holdTTL - time.Second)
+	if released := s.releaseExpiredHolds(); released != 0 {
+		t.Fatalf("released %d holds before they expired", released)
+	}
+
+	clock.Advance(time.Second)
+	if released := s.releaseExpiredHolds(); released != 1 {
+		t.Fatalf("released %d holds, want 1", released)
+	}
+
+	_, err := s.ConfirmHold(ctx, &pb.ConfirmHoldRequest{HoldId: hold.HoldId})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("ConfirmHold after the reaper released the hold: got %v, want NotFound", err)
+	}
+
+	receipt, err := s.ShowReceipt(ctx, &pb.ShowReceiptRequest{PurchaseId: waiting.PurchaseId})
+	if err != nil {
+		t.Fatalf("ShowReceipt: %v", err)
+	}
+	seat := receipt.GetUserInfo().GetSeat()
+	if seat.GetSection() != "A" || seat.GetSeatNumber() != 10 {
+		t.Fatalf("waiting buyer is in seat %s%d, want A10", seat.GetSection(), seat.GetSeatNumber())
+	}
+	checkNoDoubleBooking(t, s)
+}
+
+func TestRejectedSeatChangeKeepsHold(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(s *Server, purchaseID string) error
+		want codes.Code
+	}{
+		{"hold a taken seat", func(s *Server, purchaseID string) error {
+			_, err := s.HoldSeat(adminContext(), &pb.HoldSeatRequest{PurchaseId: purchaseID, Section: "A", SeatNumber: 3})
+			return err
+		}, codes.ResourceExhausted},
+		{"hold a seat out of range", func(s *Server, purchaseID string) error {
+			_, err := s.HoldSeat(adminContext(), &pb.HoldSeatRequest{PurchaseId: purchaseID, Section: "A", SeatNumber: 99})
+			return err
+		}, codes.InvalidArgument},
+		{"move to a taken seat", func(s *Server, purchaseID string) error {
+			_, err := s.ModifySeat(adminContext(), &pb.ModifySeatRequest{PurchaseId: purchaseID, NewSection: "A", NewSeatNumber: 3})
+			return err
+		}, codes.ResourceExhausted},
+	}
+	for _, tt := range tests {
+		s, _, hold := newHeldSeatServer(t)
+
+		if err := tt.call(s, hold.PurchaseId); status.Code(err) != tt.want {
+			t.Fatalf("%s: got %v, want %v", tt.name, err, tt.want)
+		}
+		response, err := s.ConfirmHold(adminContext(), &pb.ConfirmHoldRequest{HoldId: hold.HoldId})
+		if err != nil {
+			t.Fatalf("%s: ConfirmHold of the first hold: %v", tt.name, err)
+		}
+		if seat := response.Receipt.Seat; seat.GetSection() != "A" || seat.GetSeatNumber() != 10 {
+			t.Fatalf("%s: confirmed into seat %s, want A-10", tt.name, seatLabel(seat))
+		}
+	}
+}
+
+func TestHoldOnBlockedSeatCannotBeConfirmed(t *testing.T) {
+	s, _, hold := newHeldSeatServer(t)
+	ctx := adminContext()
+
+	admin := &AdminServer{Server: s}
+	_, err := admin.BlockSeats(ctx, &pb.BlockSeatsRequest{TrainId: "T1", Section: "A", SeatNumbers: []int32{10}, Reason: "Broken seat"})
+	if err != nil {
+		t.Fatalf("BlockSeats: %v", err)
+	}
+
+	_, err = s.ConfirmHold(ctx, &pb.ConfirmHoldRequest{HoldId: hold.HoldId})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("ConfirmHold of a blocked seat: got %v, want FailedPrecondition", err)
+	}
+	// The hold is gone, so it no longer keeps anyone else waiting
+	_, err = s.ConfirmHold(ctx, &pb.ConfirmHoldRequest{HoldId: hold.HoldId})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("ConfirmHold again: got %v, want NotFound", err)
+	}
+	checkNoDoubleBooking(t, s)
+}
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Metadata entry a client sets to make retrying a call safe
+const idempotencyKeyHeader = "idempotency-key"
+
+// How long a key is remembered unless told otherwise
+const defaultIdempotencyWindow = 24 * time.Hour
+
+// How often remembered keys are checked for expiry
+const idempotencyPruneInterval = time.Minute
+
+// Methods whose responses are remembered by idempotency key
+var idempotentMethods = map[string]bool{
+	"/ticket_service.TicketService/PurchaseTicket": true,
+	"/ticket_service.TicketService/AllocateSeat":   true,
+	"/ticket_service.TicketService/ModifySeat":     true,
+}
+
+// idempotencyEntry is a call made with an idempotency key. done is closed
+// once the call finishes; response is then set if it succeeded.
+type idempotencyEntry struct {
+	fingerprint [sha256.Size]byte
+	done        chan struct{}
+	response    proto.Message
+	expires     time.Time
+}
+
+// idempotencyCache remembers the responses of calls made with an
+// idempotency key so a retry gets the original response instead of acting
+// twice. Only successful calls are remembered; a failed one can be retried
+// with the same key. Keys are held in memory, so they are forgotten when
+// the server restarts.
+type idempotencyCache struct {
+	mu        sync.Mutex
+	window    time.Duration
+	entries   map[string]*idempotencyEntry
+	lastPrune time.Time
+}
+
+func newIdempotencyCache(window time.Duration) *idempotencyCache {
+	return &idempotencyCache{
+		window:  window,
+		entries: make(map[string]*idempotencyEntry),
+	}
+}
+
+// claim returns the entry for a key, creating it if the key is new. owner
+// tells whether the caller created it and so must make the call and finish
+// the entry.
+func (c *idempotencyCache) claim(id string, fingerprint [sha256.Size]byte, now time.Time) (entry *idempotencyEntry, owner bool, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.prune(now)
+
+	if entry, ok := c.entries[id]; ok {
+		if entry.fingerprint != fingerprint {
+			return nil, false, status.Errorf(codes.InvalidArgument, "Invalid request: Idempotency key was already used for a different request")
+		}
+		return entry, false, nil
+	}
+
+	entry = &idempotencyEntry{fingerprint: fingerprint, done: make(chan struct{})}
+	c.entries[id] = entry
+	return entry, true, nil
+}
+
+// finish records the outcome of a claimed call and wakes any retries
+// waiting for it.
+func (c *idempotencyCache) finish(id string, entry *idempotencyEntry, response interface{}, err error, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if message, ok := response.(proto.Message); ok && err == nil {
+		entry.response = proto.Clone(message)
+		entry.expires = now.Add(c.window)
+
+		// Access tokens are not kept: the key outlives the token. The
+		// booking secret is, since a buyer whose response was lost has no
+		// other way to get it; the key is scoped to the buyer's email.
+		if purchase, ok := entry.response.(*pb.PurchaseResponse); ok {
+			purchase.AccessToken = ""
+		}
+	} else if c.entries[id] == entry {
+		delete(c.entries, id)
+	}
+	close(entry.done)
+}
+
+// Helper function to forget keys whose window has passed.
+// Callers must hold c.mu.
+func (c *idempotencyCache) prune(now time.Time) {
+	if now.Sub(c.lastPrune) < idempotencyPruneInterval {
+		return
+	}
+	c.lastPrune = now
+
+	for id, entry := range c.entries {
+		if entry.response != nil && !now.Before(entry.expires) {
+			delete(c.entries, id)
+		}
+	}
+}
+
+// Helper function to read the idempotency key of a call, if it has one
+func idempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(idempotencyKeyHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// idempotencyInterceptor answers a repeated PurchaseTicket, AllocateSeat or
+// ModifySeat call carrying the same idempotency key with the response to
+// the first. A retry that arrives while the first call is still running
+// waits for it.
+func (s *Server) idempotencyInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	key := idempotencyKey(ctx)
+	message, ok := req.(proto.Message)
+	if key == "" || !ok || !idempotentMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	// The key belongs to one request: reusing it with different fields is
+	// a client bug, not a retry
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: %v", err)
+	}
+	fingerprint := sha256.Sum256(encoded)
+
+	// Keys are scoped to the caller, so one client cannot replay another's.
+	// Buyers who did not sign in are told apart by the email they buy for.
+	caller := ""
+	if c := claimsFrom(ctx); c != nil {
+		caller = c.Subject
+	} else if buyer, ok := req.(interface{ GetUser() *pb.User }); ok {
+		caller = "anonymous " + buyer.GetUser().GetEmail()
+	}
+	id := info.FullMethod + " " + caller + " " + key
+
+	for {
+		entry, owner, err := s.idempotency.claim(id, fingerprint, s.now())
+		if err != nil {
+			return nil, err
+		}
+
+		if owner {
+			response, err := handler(ctx, req)
+			s.idempotency.finish(id, entry, response, err, s.now())
+			return response, err
+		}
+
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		if entry.response != nil {
+			return proto.Clone(entry.response), nil
+		}
+		// The first call failed, so this one is tried afresh
+	}
+}
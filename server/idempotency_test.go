@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Helper function to buy a ticket through the idempotency interceptor with
+// an idempotency key
+func purchaseWithKey(t *testing.T, s *Server, ctx context.Context, key, email string) *pb.PurchaseResponse {
+	t.Helper()
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(idempotencyKeyHeader, key))
+	req := &pb.PurchaseRequest{
+		From: "London", To: "Paris",
+		User: &pb.User{FirstName: "Test", LastName: "Buyer", Email: email},
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/ticket_service.TicketService/PurchaseTicket"}
+	response, err := s.idempotencyInterceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.PurchaseTicket(ctx, req.(*pb.PurchaseRequest))
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket with key %s for %s: %v", key, email, err)
+	}
+	return response.(*pb.PurchaseResponse)
+}
+
+func TestIdempotencyKeysOfAnonymousBuyersAreScopedByEmail(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	first := purchaseWithKey(t, s, ctx, "key-1", "first@example.com")
+	if retry := purchaseWithKey(t, s, ctx, "key-1", "first@example.com"); retry.PurchaseId != first.PurchaseId {
+		t.Fatalf("retry bought purchase %s, want the original %s", retry.PurchaseId, first.PurchaseId)
+	}
+
+	// Another buyer using the same key gets their own ticket, not the
+	// first buyer's
+	other := purchaseWithKey(t, s, ctx, "key-1", "other@example.com")
+	if other.PurchaseId == first.PurchaseId {
+		t.Fatalf("a different buyer reusing the key got purchase %s of first@example.com", first.PurchaseId)
+	}
+}
+
+func TestIdempotencyCacheKeepsNoAccessTokens(t *testing.T) {
+	s := newTestServer(t)
+	ctx := passengerContext("jo@example.com")
+
+	first := purchaseWithKey(t, s, ctx, "key-1", "jo@example.com")
+	if first.AccessToken == "" {
+		t.Fatalf("signed-in purchase returned no renewed token")
+	}
+	retry := purchaseWithKey(t, s, ctx, "key-1", "jo@example.com")
+	if retry.PurchaseId != first.PurchaseId {
+		t.Fatalf("retry bought purchase %s, want the original %s", retry.PurchaseId, first.PurchaseId)
+	}
+	if retry.AccessToken != "" {
+		t.Fatalf("retry was answered with a remembered access token")
+	}
+}
+
+func TestRetryAfterLostResponseCanSignIn(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+
+	// The first response never reaches the buyer, so all they have is the
+	// retry
+	purchaseWithKey(t, s, ctx, "key-1", "jo@example.com")
+	retry := purchaseWithKey(t, s, ctx, "key-1", "jo@example.com")
+	if retry.BookingSecret == "" {
+		t.Fatalf("retry was answered without the booking secret")
+	}
+	_, err := s.Login(ctx, &pb.LoginRequest{Email: "jo@example.com", PurchaseId: retry.PurchaseId, BookingSecret: retry.BookingSecret})
+	if err != nil {
+		t.Fatalf("Login with the secret from the retry: %v", err)
+	}
+}
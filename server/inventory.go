@@ -0,0 +1,131 @@
+package main
+
+import (
+	"slices"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+)
+
+// Leg is the part of a train's route a passenger travels on, as stop
+// indices: the passenger boards at stop From and leaves at stop To. A seat
+// is in use on every segment between the two, so London -> Paris and
+// Paris -> Brussels can share a seat while London -> Brussels cannot.
+type Leg struct {
+	From int
+	To   int
+}
+
+// Overlaps reports whether two legs share at least one segment.
+func (l Leg) Overlaps(other Leg) bool {
+	return l.From < other.To && other.From < l.To
+}
+
+// FullRoute is the leg from the first to the last stop of a train.
+func (t *Train) FullRoute() Leg {
+	return Leg{From: 0, To: len(t.Stops) - 1}
+}
+
+// LegBetween returns the leg from one station to another.
+func (t *Train) LegBetween(from, to string) Leg {
+	return Leg{From: t.StopIndex(from), To: t.StopIndex(to)}
+}
+
+// Helper function to find the leg a receipt travels on. Receipts for
+// stations the train no longer calls at are treated as using the whole
+// route, so they keep their seat.
+func receiptLeg(train *Train, receipt *pb.Receipt) Leg {
+	leg := train.LegBetween(receipt.From, receipt.To)
+	if leg.From < 0 || leg.To <= leg.From {
+		return train.FullRoute()
+	}
+	return leg
+}
+
+// Helper function to check whether a seat is free for the whole of leg:
+// it is not blocked, nobody is seated in it and nobody holds it. Callers
+// must hold s.mu.
+func (s *Server) seatFree(train *Train, departure Departure, section string, seatNumber int32, leg Leg) bool {
+	return s.seatFreeWithout(train, departure, section, seatNumber, leg)
+}
+
+// Helper function to check whether a seat would be free for the whole of
+// leg if the given tickets gave up their seats. Callers must hold s.mu.
+func (s *Server) seatFreeWithout(train *Train, departure Departure, section string, seatNumber int32, leg Leg, purchaseIDs ...string) bool {
+	if s.blocks.SeatBlocked(departure, section, seatNumber) {
+		return false
+	}
+	if s.seatHeld(seatKey{departure: departure, section: section, seatNumber: seatNumber}, leg) {
+		return false
+	}
+	for _, occupant := range s.store.SeatOccupants(departure, section, seatNumber) {
+		if !slices.Contains(purchaseIDs, occupant.PurchaseId) && receiptLeg(train, occupant).Overlaps(leg) {
+			return false
+		}
+	}
+	return true
+}
+
+// Helper function to find the lowest numbered seat in a section that is
+// free for the whole of leg. Callers must hold s.mu.
+func (s *Server) findNextAvailableSeat(train *Train, departure Departure, section *SectionLayout, leg Leg) (int32, bool) {
+	for _, seat := range section.Seats() {
+		if s.seatFree(train, departure, section.Name, seat.Number, leg) {
+			return seat.Number, true
+		}
+	}
+	return -1, false
+}
+
+// Helper function to count the seats of a departure that are free for the
+// whole of leg. Callers must hold s.mu.
+func (s *Server) availableSeats(train *Train, departure Departure, leg Leg) int32 {
+	var available int32
+	for _, section := range train.Sections {
+		for _, seat := range section.Seats() {
+			if s.seatFree(train, departure, section.Name, seat.Number, leg) {
+				available++
+			}
+		}
+	}
+	return available
+}
+
+// Helper function to pick count seats in a section that are free for the
+// whole of leg, lowest numbered first. With contiguous set the seats are
+// side by side: in one row, on one side of the aisle. Callers must hold
+// s.mu.
+func (s *Server) findSeats(train *Train, departure Departure, section *SectionLayout, leg Leg, count int, contiguous bool) ([]int32, bool) {
+	seats := section.Seats()
+	free := make([]bool, len(seats))
+	for i, seat := range seats {
+		free[i] = s.seatFree(train, departure, section.Name, seat.Number, leg)
+	}
+
+	if !contiguous {
+		var numbers []int32
+		for i, seat := range seats {
+			if free[i] {
+				numbers = append(numbers, seat.Number)
+			}
+			if len(numbers) == count {
+				return numbers, true
+			}
+		}
+		return nil, false
+	}
+
+	for start := 0; start+count <= len(seats); start++ {
+		fits := free[start]
+		for i := start + 1; fits && i < start+count; i++ {
+			fits = free[i] && seats[i].NextTo(seats[i-1])
+		}
+		if fits {
+			numbers := make([]int32, 0, count)
+			for i := start; i < start+count; i++ {
+				numbers = append(numbers, seats[i].Number)
+			}
+			return numbers, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+)
+
+func TestLegOverlaps(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Leg
+		want bool
+	}{
+		{"same leg", Leg{0, 1}, Leg{0, 1}, true},
+		{"one inside the other", Leg{0, 3}, Leg{1, 2}, true},
+		{"sharing a segment", Leg{0, 2}, Leg{1, 3}, true},
+		{"touching at a stop", Leg{0, 1}, Leg{1, 2}, false},
+		{"touching at a stop, other way round", Leg{1, 2}, Leg{0, 1}, false},
+		{"apart", Leg{0, 1}, Leg{2, 3}, false},
+	}
+	for _, test := range tests {
+		if got := test.a.Overlaps(test.b); got != test.want {
+			t.Errorf("%s: %v.Overlaps(%v) = %v, want %v", test.name, test.a, test.b, got, test.want)
+		}
+		if got := test.b.Overlaps(test.a); got != test.want {
+			t.Errorf("%s: %v.Overlaps(%v) = %v, want %v", test.name, test.b, test.a, got, test.want)
+		}
+	}
+}
+
+// Helper function to seat a ticket in section A and move it to seatNumber
+func seatInA(t *testing.T, s *Server, purchaseID string, seatNumber int32) error {
+	t.Helper()
+	ctx := adminContext()
+	if _, err := s.AllocateSeat(ctx, &pb.AllocateSeatRequest{PurchaseId: purchaseID, Section: "A"}); err != nil {
+		t.Fatalf("AllocateSeat(%s): %v", purchaseID, err)
+	}
+	_, err := s.ModifySeat(ctx, &pb.ModifySeatRequest{PurchaseId: purchaseID, NewSection: "A", NewSeatNumber: seatNumber})
+	return err
+}
+
+func TestSeatSharedByConsecutiveLegs(t *testing.T) {
+	s := newTestServer(t)
+
+	londonParis := purchaseTicket(t, s, "first@example.com", "London", "Paris")
+	if err := seatInA(t, s, londonParis.PurchaseId, 5); err != nil {
+		t.Fatalf("seating London - Paris in A5: %v", err)
+	}
+
+	// The seat is free again from Paris
+	parisBrussels := purchaseTicket(t, s, "second@example.com", "Paris", "Brussels")
+	if err := seatInA(t, s, parisBrussels.PurchaseId, 5); err != nil {
+		t.Fatalf("seating Paris - Brussels in A5 after London - Paris: %v", err)
+	}
+
+	// but not for the whole route
+	londonBrussels := purchaseTicket(t, s, "third@example.com", "London", "Brussels")
+	if err := seatInA(t, s, londonBrussels.PurchaseId, 5); err == nil {
+		t.Fatalf("seated London - Brussels in A5, which is taken on both legs")
+	}
+	checkNoDoubleBooking(t, s)
+}
+
+func TestFindSeatsKeepsContiguousSeatsSideBySide(t *testing.T) {
+	s := newTestServer(t)
+	train := s.catalog.Train("T1")
+	departure := Departure{TrainID: train.ID, Date: "2024-06-01"}
+
+	// 1A 2B 3C | 4D in the first row, 5A 6B 7C | 8D in the second
+	section := &SectionLayout{Name: "A", Rows: 2, Columns: "ABC|D"}
+	if err := section.buildSeats(); err != nil {
+		t.Fatalf("buildSeats: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		blocked    []int32
+		count      int
+		contiguous bool
+		want       []int32
+	}{
+		{"front of the first row", nil, 3, true, []int32{1, 2, 3}},
+		{"next row when the first is broken up", []int32{2}, 3, true, []int32{5, 6, 7}},
+		{"not across the aisle", []int32{1, 2}, 2, true, []int32{5, 6}},
+		{"not across the end of a row", []int32{1, 2, 3, 6, 7, 8}, 2, true, nil},
+		{"no run that long", nil, 4, true, nil},
+		{"any seats when not together", []int32{1, 2, 3, 6, 7, 8}, 2, false, []int32{4, 5}},
+	}
+	for _, tt := range tests {
+		s.blocks = newMemoryStore()
+		for _, seatNumber := range tt.blocked {
+			if err := s.blocks.PutSeatBlock(&pb.SeatBlock{TrainId: train.ID, Section: "A", SeatNumber: seatNumber}); err != nil {
+				t.Fatalf("PutSeatBlock: %v", err)
+			}
+		}
+
+		got, ok := s.findSeats(train, departure, section, train.FullRoute(), tt.count, tt.contiguous)
+		if ok != (tt.want != nil) || !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got seats %v (found %v), want %v", tt.name, got, ok, tt.want)
+		}
+	}
+}
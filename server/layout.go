@@ -0,0 +1,209 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// aisleMarker separates the seat columns on either side of an aisle.
+const aisleMarker = '|'
+
+// Layout describes every section (coach) of the train and its seats.
+type Layout struct {
+	Sections []*SectionLayout `yaml:"sections"`
+}
+
+// SectionLayout describes one section. Seats are numbered from 1 row by row,
+// left to right across Columns. Columns lists one letter per seat position
+// with '|' marking the aisle, so "AB|CD" is two seats either side of an
+// aisle: A and D are window seats, B and C are aisle seats. Seats face the
+// direction of travel except in BackwardRows, and rows in ExitRows (by
+// default the first and last) are next to a door.
+type SectionLayout struct {
+	Name         string `yaml:"name"`
+	Coach        string `yaml:"coach"`
+	Class        string `yaml:"class"`
+	Rows         int    `yaml:"rows"`
+	Columns      string `yaml:"columns"`
+	Capacity     int    `yaml:"capacity"`
+	Quiet        bool   `yaml:"quiet"`
+	BackwardRows []int  `yaml:"backward_rows"`
+	ExitRows     []int  `yaml:"exit_rows"`
+
+	seats []SeatLayout
+}
+
+// SeatLayout is the position and attributes of a single seat. Position is
+// the seat's index in its section's Columns, counting the aisle, so seats
+// in the same row are side by side when their positions differ by one.
+type SeatLayout struct {
+	Number   int32
+	Row      int32
+	Column   string
+	Position int
+	Window   bool
+	Aisle    bool
+	Forward  bool
+	NearExit bool
+}
+
+// defaultLayout is the layout of trains whose catalog entry does not define
+// one: two standard class sections, A and B, of ten seats each.
+func defaultLayout() *Layout {
+	layout := &Layout{
+		Sections: []*SectionLayout{
+			{Name: "A", Coach: "1", Class: "standard", Rows: 5, Columns: "A|B"},
+			{Name: "B", Coach: "2", Class: "standard", Rows: 5, Columns: "A|B"},
+		},
+	}
+	if err := layout.validate(); err != nil {
+		panic(err)
+	}
+	return layout
+}
+
+// validate checks the layout and numbers the seats of every section.
+func (l *Layout) validate() error {
+	if len(l.Sections) == 0 {
+		return fmt.Errorf("no sections defined")
+	}
+
+	names := make(map[string]bool)
+	for i, section := range l.Sections {
+		if section == nil || section.Name == "" {
+			return fmt.Errorf("section %d has no name", i+1)
+		}
+		if names[section.Name] {
+			return fmt.Errorf("section %s defined twice", section.Name)
+		}
+		names[section.Name] = true
+
+		if section.Class == "" {
+			return fmt.Errorf("section %s has no class", section.Name)
+		}
+		if err := section.buildSeats(); err != nil {
+			return fmt.Errorf("section %s: %w", section.Name, err)
+		}
+	}
+	return nil
+}
+
+// Helper function to derive the seats of a section from its rows and columns
+func (s *SectionLayout) buildSeats() error {
+	if s.Rows < 1 {
+		return fmt.Errorf("rows must be at least 1")
+	}
+
+	type column struct {
+		name     string
+		position int
+		window   bool
+		aisle    bool
+	}
+	var columns []column
+	seen := make(map[rune]bool)
+	positions := []rune(s.Columns)
+	for i, r := range positions {
+		if r == aisleMarker {
+			continue
+		}
+		if seen[r] {
+			return fmt.Errorf("column %c listed twice", r)
+		}
+		seen[r] = true
+		columns = append(columns, column{
+			name:     string(r),
+			position: i,
+			aisle:    (i > 0 && positions[i-1] == aisleMarker) || (i < len(positions)-1 && positions[i+1] == aisleMarker),
+		})
+	}
+	if len(columns) == 0 {
+		return fmt.Errorf("no seat columns defined")
+	}
+	columns[0].window = true
+	columns[len(columns)-1].window = true
+
+	maxSeats := s.Rows * len(columns)
+	if s.Capacity == 0 {
+		s.Capacity = maxSeats
+	}
+	if s.Capacity < 1 || s.Capacity > maxSeats {
+		return fmt.Errorf("capacity must be between 1 and %d", maxSeats)
+	}
+
+	backward, err := rowSet(s.BackwardRows, s.Rows, "backward_rows")
+	if err != nil {
+		return err
+	}
+	if len(s.ExitRows) == 0 {
+		s.ExitRows = []int{1, s.Rows}
+	}
+	exits, err := rowSet(s.ExitRows, s.Rows, "exit_rows")
+	if err != nil {
+		return err
+	}
+
+	s.seats = make([]SeatLayout, 0, s.Capacity)
+	for number := 1; number <= s.Capacity; number++ {
+		col := columns[(number-1)%len(columns)]
+		row := (number-1)/len(columns) + 1
+		s.seats = append(s.seats, SeatLayout{
+			Number:   int32(number),
+			Row:      int32(row),
+			Column:   col.name,
+			Position: col.position,
+			Window:   col.window,
+			Aisle:    col.aisle,
+			Forward:  !backward[row],
+			NearExit: exits[row],
+		})
+	}
+	return nil
+}
+
+// Helper function to check a list of row numbers and return it as a set
+func rowSet(rows []int, count int, field string) (map[int]bool, error) {
+	set := make(map[int]bool, len(rows))
+	for _, row := range rows {
+		if row < 1 || row > count {
+			return nil, fmt.Errorf("%s: row %d must be between 1 and %d", field, row, count)
+		}
+		set[row] = true
+	}
+	return set, nil
+}
+
+// NextTo reports whether two seats of the section are side by side: in the
+// same row with no aisle between them.
+func (a SeatLayout) NextTo(b SeatLayout) bool {
+	return a.Row == b.Row && (a.Position-b.Position == 1 || b.Position-a.Position == 1)
+}
+
+// Section returns the named section, or nil if the train has no such section.
+func (l *Layout) Section(name string) *SectionLayout {
+	for _, section := range l.Sections {
+		if section.Name == name {
+			return section
+		}
+	}
+	return nil
+}
+
+// SectionNames lists the sections in layout order.
+func (l *Layout) SectionNames() string {
+	names := make([]string, 0, len(l.Sections))
+	for _, section := range l.Sections {
+		names = append(names, section.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// Seats returns the seats of the section in seat number order.
+func (s *SectionLayout) Seats() []SeatLayout {
+	return s.seats
+}
+
+// HasSeat reports whether seatNumber exists in the section.
+func (s *SectionLayout) HasSeat(seatNumber int32) bool {
+	return seatNumber >= 1 && int(seatNumber) <= len(s.seats)
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+)
+
+func TestBuildSeats(t *testing.T) {
+	section := &SectionLayout{Name: "A", Class: "standard", Rows: 3, Columns: "AB|CD", Capacity: 10, BackwardRows: []int{1}}
+	if err := section.buildSeats(); err != nil {
+		t.Fatalf("buildSeats: %v", err)
+	}
+	if got := len(section.Seats()); got != 10 {
+		t.Fatalf("got %d seats, want the capacity of 10", got)
+	}
+
+	tests := []struct {
+		number                           int32
+		row                              int32
+		column                           string
+		window, aisle, forward, nearExit bool
+	}{
+		{1, 1, "A", true, false, false, true},
+		{2, 1, "B", false, true, false, true},
+		{3, 1, "C", false, true, false, true},
+		{4, 1, "D", true, false, false, true},
+		{6, 2, "B", false, true, true, false},
+		{9, 3, "A", true, false, true, true},
+		{10, 3, "B", false, true, true, true},
+	}
+	for _, tt := range tests {
+		seat := section.Seats()[tt.number-1]
+		if seat.Number != tt.number || seat.Row != tt.row || seat.Column != tt.column {
+			t.Errorf("seat %d: got seat %d in row %d, column %s, want row %d, column %s", tt.number, seat.Number, seat.Row, seat.Column, tt.row, tt.column)
+		}
+		if seat.Window != tt.window || seat.Aisle != tt.aisle || seat.Forward != tt.forward || seat.NearExit != tt.nearExit {
+			t.Errorf("seat %d: got window %v, aisle %v, forward %v, near exit %v, want %v, %v, %v, %v",
+				tt.number, seat.Window, seat.Aisle, seat.Forward, seat.NearExit, tt.window, tt.aisle, tt.forward, tt.nearExit)
+		}
+	}
+
+	// B and C are in the same row but the aisle is between them
+	seats := section.Seats()
+	if !seats[0].NextTo(seats[1]) || seats[1].NextTo(seats[2]) || seats[3].NextTo(seats[4]) {
+		t.Fatal("NextTo must pair seats in one row on one side of the aisle")
+	}
+}
+
+func TestLayoutValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		layout  Layout
+		wantErr string
+	}{
+		{"no sections", Layout{}, "no sections defined"},
+		{"no name", Layout{Sections: []*SectionLayout{{Class: "standard", Rows: 1, Columns: "A"}}}, "section 1 has no name"},
+		{"duplicate", Layout{Sections: []*SectionLayout{
+			{Name: "A", Class: "standard", Rows: 1, Columns: "A"},
+			{Name: "A", Class: "standard", Rows: 1, Columns: "A"},
+		}}, "section A defined twice"},
+		{"no rows", Layout{Sections: []*SectionLayout{{Name: "A", Class: "standard", Columns: "A"}}}, "rows must be at least 1"},
+		{"no columns", Layout{Sections: []*SectionLayout{{Name: "A", Class: "standard", Rows: 1, Columns: "|"}}}, "no seat columns defined"},
+		{"repeated column", Layout{Sections: []*SectionLayout{{Name: "A", Class: "standard", Rows: 1, Columns: "AB|A"}}}, "column A listed twice"},
+		{"capacity too large", Layout{Sections: []*SectionLayout{{Name: "A", Class: "standard", Rows: 2, Columns: "A|B", Capacity: 5}}}, "capacity must be between 1 and 4"},
+		{"backward row outside", Layout{Sections: []*SectionLayout{{Name: "A", Class: "standard", Rows: 2, Columns: "A|B", BackwardRows: []int{3}}}}, "backward_rows: row 3 must be between 1 and 2"},
+		{"exit row outside", Layout{Sections: []*SectionLayout{{Name: "A", Class: "standard", Rows: 2, Columns: "A|B", ExitRows: []int{0}}}}, "exit_rows: row 0 must be between 1 and 2"},
+		{"valid", Layout{Sections: []*SectionLayout{{Name: "A", Class: "standard", Rows: 2, Columns: "A|B"}}}, ""},
+	}
+	for _, tt := range tests {
+		err := tt.layout.validate()
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: validate: %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: got error %v, want one containing %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestGetSeatMapShowsOccupiedSeats(t *testing.T) {
+	s := newTestServer(t)
+	seatedInA(t, s, "first@example.com", "London", "Paris")
+	seatedInA(t, s, "second@example.com", "London", "Paris")
+
+	tests := []struct {
+		from, to     string
+		wantOccupied int32
+	}{
+		{"", "", 2},
+		{"London", "Paris", 2},
+		{"Paris", "Brussels", 0},
+	}
+	for _, tt := range tests {
+		seatMap, err := s.GetSeatMap(adminContext(), &pb.GetSeatMapRequest{Section: "A", From: tt.from, To: tt.to})
+		if err != nil {
+			t.Fatalf("GetSeatMap(%q, %q): %v", tt.from, tt.to, err)
+		}
+		if len(seatMap.Sections) != 1 {
+			t.Fatalf("got %d sections, want only A", len(seatMap.Sections))
+		}
+		section := seatMap.Sections[0]
+		if len(section.Seats) != 10 || section.Available != 10-tt.wantOccupied {
+			t.Errorf("%q to %q: got %d seats with %d available, want 10 with %d", tt.from, tt.to, len(section.Seats), section.Available, 10-tt.wantOccupied)
+		}
+		for _, seat := range section.Seats {
+			if want := seat.SeatNumber <= tt.wantOccupied; seat.Occupied != want {
+				t.Errorf("%q to %q: seat A-%d got occupied %v, want %v", tt.from, tt.to, seat.SeatNumber, seat.Occupied, want)
+			}
+		}
+	}
+}
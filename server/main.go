@@ -6,29 +6,73 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	pb "github.com/harshithvh/go_gRPC/proto"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
+// Server serializes every mutation of the booking state behind mu. A single
+// lock keeps multi-step operations such as ModifySeat (free one seat, take
+// another, possibly in a different section) atomic; read-only handlers share
+// the lock. Receipts come out of the store as copies, so callers never
+// observe a receipt mid-update.
 type Server struct {
-    userInfo map[string]*pb.Receipt 
-    seatAvailabilityA [10]bool
-	seatAvailabilityB [10]bool
+	mu             sync.RWMutex
+	store          BookingStore
+	promos         PromoStore
+	blocks         SeatBlockStore
+	catalog        *Catalog
+	holds          *holdTable
+	holdTTL        time.Duration
+	watchers       map[*seatWatcher]struct{}
+	payments       PaymentProvider
+	paymentTimeout time.Duration
+	idempotency    *idempotencyCache
+	auth           *authenticator
+	adminSecret    string
+	now            func() time.Time
 	pb.UnimplementedTicketServiceServer
 }
 
-// Helper function to find the next available seat in a section
-func findNextAvailableSeat(seatAvailability *[10]bool) (int, bool) {
-	for seatNumber, available := range seatAvailability {
-		if !available {
-			return seatNumber, true
-		}
+// newServer returns a Server selling tickets for the trains in catalog.
+func newServer(store BookingStore, promos PromoStore, blocks SeatBlockStore, catalog *Catalog) *Server {
+	return &Server{
+		store:          store,
+		promos:         promos,
+		blocks:         blocks,
+		catalog:        catalog,
+		holds:          newHoldTable(),
+		holdTTL:        defaultHoldTTL,
+		watchers:       make(map[*seatWatcher]struct{}),
+		payments:       newFakePaymentProvider(),
+		paymentTimeout: defaultPaymentTimeout,
+		idempotency:    newIdempotencyCache(defaultIdempotencyWindow),
+		auth:           newAuthenticator(randomSecret(), defaultTokenTTL),
+		now:            time.Now,
 	}
-	return -1, false
+}
+
+// Helper function to check that a ticket was paid for the class of a section
+func checkSeatClass(receipt *pb.Receipt, section *SectionLayout) error {
+	if receipt.SeatClass != "" && receipt.SeatClass != section.Class {
+		return status.Errorf(codes.FailedPrecondition, "Section %s is %s class but the ticket is for %s class", section.Name, section.Class, receipt.SeatClass)
+	}
+	return nil
+}
+
+// Helper function to report a storage failure to the caller
+func storeError(err error) error {
+	log.Printf("booking store: %v", err)
+	return status.Errorf(codes.Internal, "Failed to save booking")
 }
 
 // gRPC methods:
@@ -38,100 +82,377 @@ func (s *Server) PurchaseTicket(ctx context.Context, req *pb.PurchaseRequest) (*
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: First name, last name, and email cannot be empty")
 	}
 
-	price := 20.0
+	train, date, err := s.resolveJourney(req.TrainId, req.Date, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	departureTime := train.StopTime(date, req.From).Format(time.RFC3339)
 
-	purchaseID := uuid.New().String()
+	seatClass, err := s.resolveSeatClass(train, req.SeatClass)
+	if err != nil {
+		return nil, err
+	}
 
-	// Check if a ticket with the same email already exists
-    _, exists := s.userInfo[req.User.Email]
-    if exists {
-        return nil, status.Errorf(codes.AlreadyExists, "Ticket already purchased for the provided email: %s", req.User.Email)
-    }
+	now := s.now()
+	fare, err := s.catalog.Pricing.Quote(train, date, req.From, req.To, seatClass, now)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	passengers, err := bookingPassengers(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// A signed-in passenger books in their own name
+	if claimsFrom(ctx) != nil {
+		if err := s.authorizeEmail(ctx, req.User.Email); err != nil {
+			return nil, err
+		}
+	}
+
+	bookingID := uuid.New().String()
+	bookingSecret, secretHash := newBookingSecret()
+
+	s.mu.Lock()
+
+	// Anyone may buy without signing in, so a customer who has booked
+	// before must sign in first. Otherwise a stranger could buy in their
+	// name and sign in as them with the new booking's secret.
+	if s.auth != nil && claimsFrom(ctx) == nil && s.emailHasBookings(req.User.Email) {
+		s.mu.Unlock()
+		return nil, status.Errorf(codes.Unauthenticated, "Tickets were already booked by %s; sign in with Login to book more", req.User.Email)
+	}
+
+	// Apply the promo code, if any. A fixed amount off is for the booking,
+	// so it is spread across the tickets rather than taken off each one.
+	fares := make([]*Fare, len(passengers))
+	for i := range fares {
+		fares[i] = fare
+	}
+	var promo *pb.PromoCode
+	if req.PromoCode != "" {
+		promo, err = s.usablePromo(req.PromoCode, req.User.Email, now)
+		if err != nil {
+			s.mu.Unlock()
+			return nil, err
+		}
+		for i, discount := range promoDiscounts(promo, fare.Total, len(passengers)) {
+			fares[i] = fare.Copy()
+			fares[i].ApplyDiscount(discount)
+		}
+	}
+
+	// Issue one ticket per passenger, held until the booking is paid for
+	tickets := make([]*pb.Receipt, 0, len(passengers))
+	var totalAmount, discountAmount int64
+	for i, passenger := range passengers {
+		fare := fares[i]
+		totalAmount += fare.Total.AmountMinor
+		if fare.Discount != nil {
+			discountAmount += fare.Discount.Amount.AmountMinor
+		}
+		tickets = append(tickets, &pb.Receipt{
+			From:              req.From,
+			To:                req.To,
+			User:              passenger,
+			PricePaid:         float32(MajorUnits(fare.Total)),
+			PurchaseId:        uuid.New().String(),
+			Seat:              &pb.Seat{},
+			TrainId:           train.ID,
+			Date:              date.Format(dateLayout),
+			DepartureTime:     departureTime,
+			SeatClass:         seatClass,
+			Price:             fare.Total,
+			FareBreakdown:     fare.Lines,
+			Discount:          fare.Discount,
+			BookingId:         bookingID,
+			BookedBy:          req.User.Email,
+			Status:            pb.TicketStatus_TICKET_STATUS_PENDING_PAYMENT,
+			BookingSecretHash: secretHash,
+			Payment: &pb.Payment{
+				Provider: s.payments.Name(),
+				State:    pb.PaymentState_PAYMENT_STATE_PENDING,
+				Amount:   fare.Total,
+			},
+		})
+	}
+
+	total := s.catalog.Pricing.Money(totalAmount)
+	var discount *pb.Discount
+	if fares[0].Discount != nil {
+		discount = proto.Clone(fares[0].Discount).(*pb.Discount)
+		discount.Amount = s.catalog.Pricing.Money(discountAmount)
+	}
 
 	// Create a PurchaseResponse
 	purchaseResponse := &pb.PurchaseResponse{
-		From:       req.From,
-		To:         req.To,
-		User:       req.User,
-		PricePaid:  price,
-		PurchaseId: purchaseID,
+		From:          req.From,
+		To:            req.To,
+		User:          tickets[0].User,
+		PricePaid:     MajorUnits(total),
+		PurchaseId:    tickets[0].PurchaseId,
+		TrainId:       train.ID,
+		Date:          date.Format(dateLayout),
+		DepartureTime: departureTime,
+		SeatClass:     seatClass,
+		Price:         total,
+		FareBreakdown: SumLines(fares),
+		Discount:      discount,
+		BookingId:     bookingID,
+		Tickets:       tickets,
+		BookingSecret: bookingSecret,
+	}
+
+	// A group booking counts as a single use of the promo code
+	if promo != nil {
+		if err := s.recordPromoUse(promo, req.User.Email); err != nil {
+			s.mu.Unlock()
+			return nil, storeError(err)
+		}
+	}
+
+	// Store the tickets in the booking store
+	if err := s.putTickets(tickets, make([]*pb.Receipt, len(tickets))); err != nil {
+		if promo != nil {
+			s.releasePromoUse(promo.Code, req.User.Email)
+		}
+		s.mu.Unlock()
+		return nil, storeError(err)
+	}
+	s.mu.Unlock()
+
+	// Charge for the booking without holding up other requests
+	reference, chargeErr := s.charge(ctx, PaymentCharge{
+		BookingID: bookingID,
+		Email:     req.User.Email,
+		Token:     req.PaymentToken,
+		Amount:    total,
+	})
+
+	s.mu.Lock()
+
+	// Read the tickets back, as they may have been changed while the
+	// payment was being taken
+	pending, unchanged := s.pendingTickets(tickets)
+
+	// Without a payment the tickets are withdrawn, so no booking is left
+	// unpaid for
+	if chargeErr != nil {
+		s.withdrawTickets(pending, promo, req.User.Email)
+		s.mu.Unlock()
+		return nil, paymentError(chargeErr)
 	}
 
-	ticketInfo := &pb.Receipt{
-		From:       req.From,
-		To:         req.To,
-		User:       req.User,
-		PricePaid:  float32(price),
-		PurchaseId: purchaseID,
-		Seat:       &pb.Seat{},
+	// A booking changed in the meantime, say by an operator, is no longer
+	// the one paid for. The change stands and the payment is given back.
+	if !unchanged {
+		log.Printf("purchase: booking %s changed while %s was being taken; refunding", bookingID, reference)
+		s.withdrawTickets(pending, promo, req.User.Email)
+		s.mu.Unlock()
+		s.refundWithdrawn(reference, total)
+		return nil, status.Errorf(codes.Aborted, "Booking %s was changed while it was being paid for; the payment has been refunded", bookingID)
+	}
+
+	for i, ticket := range pending {
+		ticket.Status = pb.TicketStatus_TICKET_STATUS_PURCHASED
+		ticket.Payment.Reference = reference
+		ticket.Payment.State = pb.PaymentState_PAYMENT_STATE_CAPTURED
+		if err := s.store.PutReceipt(ticket); err != nil {
+			// Nobody pays for a booking that was not saved
+			log.Printf("booking store: purchase %s was paid for with %s but could not be saved; refunding", ticket.PurchaseId, reference)
+			s.withdrawTickets(pending, promo, req.User.Email)
+			s.mu.Unlock()
+			s.refundWithdrawn(reference, total)
+			return nil, storeError(err)
+		}
+		tickets[i] = ticket
 	}
+	s.mu.Unlock()
 
-	// Store the purchaseResponse in the Server's in-memory storage
-	s.userInfo[req.User.Email] = ticketInfo
+	purchaseResponse.PaymentReference = reference
+
+	// Renew the token of a passenger who signed in to buy. Anyone else
+	// signs in with Login and the booking secret; a token here would go to
+	// whoever typed the email.
+	if c := claimsFrom(ctx); s.auth != nil && c != nil && c.Role == rolePassenger && c.Subject == req.User.Email {
+		purchaseResponse.AccessToken, _ = s.auth.issue(req.User.Email, rolePassenger, s.now())
+	}
 
 	return purchaseResponse, nil
 }
 
+// Helper function to read back from the store the tickets of a booking
+// being paid for. It returns those still awaiting payment, and whether every
+// ticket still is, without a seat, as it was put. Callers must hold s.mu.
+func (s *Server) pendingTickets(tickets []*pb.Receipt) (pending []*pb.Receipt, unchanged bool) {
+	unchanged = true
+	for _, ticket := range tickets {
+		stored, ok := s.store.GetReceipt(ticket.PurchaseId)
+		if !ok || stored.Status != pb.TicketStatus_TICKET_STATUS_PENDING_PAYMENT {
+			unchanged = false
+			continue
+		}
+		if !proto.Equal(stored.Seat, ticket.Seat) {
+			unchanged = false
+		}
+		pending = append(pending, stored)
+	}
+	return pending, unchanged
+}
+
+// Helper function to take back the tickets of a booking that was not paid
+// for or could not be saved, with the use of its promo code, if any.
+// Callers must hold s.mu for writing.
+func (s *Server) withdrawTickets(tickets []*pb.Receipt, promo *pb.PromoCode, email string) {
+	for _, ticket := range tickets {
+		if err := s.store.DeleteReceipt(ticket.PurchaseId); err != nil {
+			log.Printf("booking store: withdrawing purchase %s: %v", ticket.PurchaseId, err)
+		}
+		s.releaseHold(ticket.PurchaseId)
+	}
+	if promo != nil {
+		s.releasePromoUse(promo.Code, email)
+	}
+}
+
 func (s *Server) AllocateSeat(ctx context.Context, req *pb.AllocateSeatRequest) (*pb.AllocateSeatResponse, error) {
 	// Validate the request
-	if req == nil || req.Email == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	if req == nil || (req.PurchaseId == "" && req.Email == "" && req.BookingId == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Purchase id cannot be empty")
 	}
 
-	purchaseInfo, exists := s.userInfo[req.Email]
-	if !exists {
-		return nil, status.Errorf(codes.NotFound, "Purchase not found for the provided email")
+	if req.Contiguous && req.BookingId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Contiguous seating needs a booking id")
 	}
 
-	// Check if the seat is already allocated for the user
-	if purchaseInfo.Seat.Section != "" && purchaseInfo.Seat.SeatNumber > 0 {
-		return nil, status.Errorf(codes.FailedPrecondition, "Seat already allocated for the user with email: %s", req.Email)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Find the tickets to seat: every unseated passenger of the booking, or
+	// a single ticket
+	var tickets []*pb.Receipt
+	if req.BookingId != "" {
+		booking := s.store.ListByBooking(req.BookingId)
+		if len(booking) == 0 {
+			return nil, status.Errorf(codes.NotFound, "Booking not found: %s", req.BookingId)
+		}
+		for _, ticket := range booking {
+			if err := s.authorizeTicket(ctx, ticket); err != nil {
+				return nil, err
+			}
+		}
+		for _, ticket := range s.refreshStatuses(booking) {
+			if ticket.Status == pb.TicketStatus_TICKET_STATUS_PURCHASED {
+				tickets = append(tickets, ticket)
+			}
+		}
+		if len(tickets) == 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "No passenger of booking %s is waiting for a seat", req.BookingId)
+		}
+	} else {
+		purchaseInfo, err := s.lookupOwnTicket(ctx, req.PurchaseId, req.Email)
+		if err != nil {
+			return nil, err
+		}
+
+		// Check if the seat is already allocated for the user
+		if purchaseInfo.Status == pb.TicketStatus_TICKET_STATUS_SEATED {
+			return nil, status.Errorf(codes.FailedPrecondition, "Seat already allocated for purchase %s", purchaseInfo.PurchaseId)
+		}
+		if err := checkTransition(purchaseInfo, pb.TicketStatus_TICKET_STATUS_SEATED, "allocate a seat"); err != nil {
+			return nil, err
+		}
+		tickets = []*pb.Receipt{purchaseInfo}
 	}
 
-	if req == nil || req.Section == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Section cannot be empty")
+	// Every ticket of a booking is for the same journey
+	train, err := s.receiptTrain(tickets[0])
+	if err != nil {
+		return nil, err
 	}
 
-	if req.Section != "A" && req.Section != "B" {
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid new section: %s", req.Section)
+	sections, err := allocationSections(train, tickets[0], req.Section, req.Preferences)
+	if err != nil {
+		return nil, err
+	}
+	neighbour, err := s.preferredNeighbour(ctx, train, tickets[0], req.Preferences.GetNextToPurchaseId())
+	if err != nil {
+		return nil, err
 	}
 
-	var seatAvailability *[10]bool
-	var seatNumber int
+	// Seats the passengers were holding are given up for the allocation
+	for _, ticket := range tickets {
+		s.releaseHold(ticket.PurchaseId)
+	}
 
-	switch req.Section {
-	case "A":
-		seatAvailability = &s.seatAvailabilityA
-		seat, available := findNextAvailableSeat(&s.seatAvailabilityA)
-		if !available {
-			return nil, status.Errorf(codes.ResourceExhausted, "No more seats available in section A")
+	// Pick the seats: the best matches for any preferences, or else the
+	// lowest numbered ones, side by side if asked
+	departure := receiptDeparture(tickets[0])
+	leg := receiptLeg(train, tickets[0])
+	var choices []seatChoice
+	available := false
+	if req.Preferences != nil && !req.Contiguous {
+		choices, available = s.chooseSeats(train, departure, leg, sections, req.Preferences, neighbour, len(tickets))
+	} else {
+		for _, section := range sections {
+			var seatNumbers []int32
+			seatNumbers, available = s.findSeats(train, departure, section, leg, len(tickets), req.Contiguous)
+			if !available {
+				continue
+			}
+			for _, seatNumber := range seatNumbers {
+				seat := sectionSeat{section: section, seat: section.Seats()[seatNumber-1]}
+				choices = append(choices, rateSeat(req.Preferences, seat, neighbour))
+			}
+			break
+		}
+	}
+	if !available {
+		where := "section " + req.Section
+		if req.Section == "" {
+			where = "train " + train.ID
 		}
-		seatNumber = seat
-	case "B":
-		seatAvailability = &s.seatAvailabilityB
-		seat, available := findNextAvailableSeat(&s.seatAvailabilityB)
-		if !available {
-			return nil, status.Errorf(codes.ResourceExhausted, "No more seats available in section B")
+		if req.Contiguous {
+			return nil, status.Errorf(codes.ResourceExhausted, "No %d adjacent seats available in %s", len(tickets), where)
 		}
-		seatNumber = seat
-	default:
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid section: %s", req.Section)
+		return nil, status.Errorf(codes.ResourceExhausted, "No more seats available in %s; join the waitlist to be seated when one frees up", where)
 	}
 
-	// Mark the seat as unavailable
-	(*seatAvailability)[seatNumber] = true
-
-	// Update the PurchaseResponse with the allocated seat information
-	purchaseInfo.Seat.Section = req.Section
-	purchaseInfo.Seat.SeatNumber = int32(seatNumber + 1)
+	// Update the receipts with the allocated seat information
+	previous := make([]*pb.Receipt, len(tickets))
+	for i, ticket := range tickets {
+		previous[i] = proto.Clone(ticket).(*pb.Receipt)
+		ticket.Seat.Section = choices[i].section.Name
+		ticket.Seat.SeatNumber = choices[i].seat.Number
+		ticket.Waitlist = nil
+		ticket.Status = pb.TicketStatus_TICKET_STATUS_SEATED
+	}
 
-	s.userInfo[req.Email] = purchaseInfo
+	if err := s.dropSwapRequests(tickets...); err != nil {
+		return nil, storeError(err)
+	}
+	if err := s.putTickets(tickets, previous); err != nil {
+		return nil, storeError(err)
+	}
+	for _, ticket := range tickets {
+		s.emitSeat(eventSeatAllocated, ticket)
+	}
 
 	// Create an AllocateSeatResponse with the allocated seat information
 	allocateSeatResponse := &pb.AllocateSeatResponse{
-		Email:      req.Email,
-		Section:    req.Section,
-		SeatNumber: int32(seatNumber+1),
+		Email:      tickets[0].User.Email,
+		Section:    tickets[0].Seat.Section,
+		SeatNumber: tickets[0].Seat.SeatNumber,
+	}
+	for i, ticket := range tickets {
+		allocateSeatResponse.Allocations = append(allocateSeatResponse.Allocations, &pb.SeatAllocation{
+			PurchaseId:        ticket.PurchaseId,
+			User:              ticket.User,
+			Seat:              ticket.Seat,
+			PreferencesMet:    choices[i].met,
+			PreferencesMissed: choices[i].missed,
+		})
 	}
 
 	return allocateSeatResponse, nil
@@ -139,19 +460,17 @@ func (s *Server) AllocateSeat(ctx context.Context, req *pb.AllocateSeatRequest)
 
 func (s *Server) ShowReceipt(ctx context.Context, req *pb.ShowReceiptRequest) (*pb.ShowReceiptResponse, error) {
 	// Validate the request
-	if req == nil || req.Email == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Email cannot be empty")
+	if req == nil || (req.PurchaseId == "" && req.Email == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Purchase id cannot be empty")
 	}
 
-	// Retrieve the purchase response based on the user's email
-	receiptInfo, exists := s.userInfo[req.Email]
-	if !exists {
-		return nil, status.Errorf(codes.NotFound, "Purchase not found for the provided email")
-	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
-	// Check if the section and seat number is allocated for the user
-	if receiptInfo.Seat.Section == "" && receiptInfo.Seat.SeatNumber == 0 {
-		return nil, status.Errorf(codes.FailedPrecondition, "No section and seat number allocated for the user with email: %s", req.Email)
+	// Retrieve the receipt based on the purchase id
+	receiptInfo, err := s.lookupOwnTicket(ctx, req.PurchaseId, req.Email)
+	if err != nil {
+		return nil, err
 	}
 
 	// Create a ShowReceiptResponse
@@ -168,16 +487,22 @@ func (s *Server) GetUsersBySection(ctx context.Context, req *pb.GetUsersBySectio
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Section cannot be empty")
 	}
 
-	// Initialize a list to store UserSeatInfo for the requested section
-	usersBySection := []*pb.Receipt{}
-
-	// Iterate through stored tickets and collect users with the requested section
-	for _, receiptInfo := range s.userInfo {
-		if receiptInfo.Seat.Section == req.Section {
-			usersBySection = append(usersBySection, receiptInfo)
+	if req.TrainId != "" {
+		train := s.catalog.Train(req.TrainId)
+		if train == nil {
+			return nil, status.Errorf(codes.NotFound, "Unknown train: %s", req.TrainId)
+		}
+		if train.Section(req.Section) == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid section: %s (available: %s)", req.Section, train.SectionNames())
 		}
 	}
 
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// Collect the users seated in the requested section
+	usersBySection := s.refreshStatuses(s.store.ListBySection(Departure{TrainID: req.TrainId, Date: req.Date}, req.Section))
+
 	// Create a GetUsersBySectionResponse
 	getUsersBySectionResponse := &pb.GetUsersBySectionResponse{
 		UserInfo: usersBySection,
@@ -188,92 +513,107 @@ func (s *Server) GetUsersBySection(ctx context.Context, req *pb.GetUsersBySectio
 
 func (s *Server) RemoveUser(ctx context.Context, req *pb.RemoveUserRequest) (*pb.RemoveUserResponse, error) {
 	// Validate the request
-	if req == nil || req.Email == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Email cannot be empty")
+	if req == nil || (req.PurchaseId == "" && req.Email == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Purchase id cannot be empty")
 	}
 
+	s.mu.Lock()
+
 	// Check if the user exists in the stored tickets
-	purchaseResponse, exists := s.userInfo[req.Email]
-	if !exists {
+	receipt, err := s.lookupOwnTicket(ctx, req.PurchaseId, req.Email)
+	if status.Code(err) == codes.NotFound || (err == nil && (receipt.Status == pb.TicketStatus_TICKET_STATUS_CANCELLED || receipt.Status == pb.TicketStatus_TICKET_STATUS_REFUNDED)) {
+		s.mu.Unlock()
 		return nil, status.Errorf(codes.NotFound, "User removed or not present")
 	}
 
-	// Mark the current seat and seat number as available
-    var currSeat *[10]bool
-
-        if purchaseResponse.Seat.Section == "A" {
-            currSeat = &s.seatAvailabilityA
-        } else {
-            currSeat = &s.seatAvailabilityB
-        }
-
-    	currentSeatNumber := int32(purchaseResponse.Seat.SeatNumber)
-    	(*currSeat)[currentSeatNumber-1] = false
+	// Cancel the ticket, which frees their seat and keeps the receipt on record
+	if err == nil {
+		err = s.cancelTicket(receipt, "Removed")
+	}
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
 
-    	// Remove the user from the stored tickets
-    	delete(s.userInfo, req.Email)
+	// Refund what is due through the payment provider
+	if _, err := s.issueRefund(ctx, receipt); err != nil {
+		return nil, err
+	}
 
-        // Create a RemoveUserResponse indicating success
-        removeUserResponse := &pb.RemoveUserResponse{
-            Res: "User removed successfully",
-        }
+	// Create a RemoveUserResponse indicating success
+	removeUserResponse := &pb.RemoveUserResponse{
+		Res: "User removed successfully",
+	}
 
-    return removeUserResponse, nil
+	return removeUserResponse, nil
 }
 
-
 func (s *Server) ModifySeat(ctx context.Context, req *pb.ModifySeatRequest) (*pb.ModifySeatResponse, error) {
 	// Validate the request
-	if req == nil || req.Email == "" || req.NewSection == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Email and new section cannot be empty")
+	if req == nil || (req.PurchaseId == "" && req.Email == "") || req.NewSection == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Purchase id and new section cannot be empty")
 	}
 
-	// Check if a purchase for the given email exists
-	purchaseResponse, exists := s.userInfo[req.Email]
-	if !exists {
-		return nil, status.Errorf(codes.NotFound, "No purchase found for the provided email")
-	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-    // Check if the requested new seat number is within the valid range (1 to 10)
-	if req.NewSeatNumber < 1 || req.NewSeatNumber > 10 {
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid new seat number. Must be between 1 and 10")
+	// Check if the purchase exists
+	purchaseResponse, err := s.lookupOwnTicket(ctx, req.PurchaseId, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkTransition(purchaseResponse, pb.TicketStatus_TICKET_STATUS_SEATED, "change seat"); err != nil {
+		return nil, err
 	}
 
-    section := req.NewSection
-
-	var seatAvailability *[10]bool
-	if section == "A" {
-		seatAvailability = &s.seatAvailabilityA
-	} else if section == "B" {
-		seatAvailability = &s.seatAvailabilityB
-	} else {
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid new section: %s", req.NewSection)
+	train, err := s.receiptTrain(purchaseResponse)
+	if err != nil {
+		return nil, err
 	}
 
-	if (*seatAvailability)[req.NewSeatNumber-1] {
-		return nil, status.Errorf(codes.ResourceExhausted, "Requested seat is not available in the specified section")
+	section := train.Section(req.NewSection)
+	if section == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid new section: %s (available: %s)", req.NewSection, train.SectionNames())
 	}
 
-	// Mark the current seat and seat number as available
-    var currSeat *[10]bool
+	if err := checkSeatClass(purchaseResponse, section); err != nil {
+		return nil, err
+	}
 
-    if purchaseResponse.Seat.Section == "A" {
-        currSeat = &s.seatAvailabilityA
-    } else {
-        currSeat = &s.seatAvailabilityB
-    }
+	// Check if the requested new seat number exists in the section
+	if !section.HasSeat(req.NewSeatNumber) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid new seat number. Must be between 1 and %d", section.Capacity)
+	}
 
-	currentSeatNumber := int32(purchaseResponse.Seat.SeatNumber)
-	(*currSeat)[currentSeatNumber-1] = false
+	// A seat the passenger was holding is given up for the new one
+	err = s.replaceHold(purchaseResponse.PurchaseId, func() error {
+		if !s.seatFree(train, receiptDeparture(purchaseResponse), section.Name, req.NewSeatNumber, receiptLeg(train, purchaseResponse)) {
+			return status.Errorf(codes.ResourceExhausted, "Requested seat is not available in the specified section")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	// Mark the new seat and seat number as unavailable
-	(*seatAvailability)[req.NewSeatNumber-1] = true
+	// Move the passenger; storing the receipt frees the old seat and takes the new one
+	previous := proto.Clone(purchaseResponse).(*pb.Receipt)
+	purchaseResponse.Seat.SeatNumber = req.NewSeatNumber
+	purchaseResponse.Seat.Section = section.Name
+	purchaseResponse.Waitlist = nil
+	purchaseResponse.Status = pb.TicketStatus_TICKET_STATUS_SEATED
 
-	// Update the seat number in the purchase response
-	purchaseResponse.Seat.SeatNumber = int32(req.NewSeatNumber)
+	if err := s.dropSwapRequests(purchaseResponse); err != nil {
+		return nil, storeError(err)
+	}
+	if err := s.store.PutReceipt(purchaseResponse); err != nil {
+		return nil, storeError(err)
+	}
+	s.emitSeat(eventSeatReleased, previous)
+	s.emitSeat(eventSeatAllocated, purchaseResponse)
 
-    // Update the section in the purchase response
-    purchaseResponse.Seat.Section = section
+	// Offer the freed seat to the waitlist
+	s.serveWaitlist(receiptDeparture(purchaseResponse))
 
 	// Create a ModifySeatResponse indicating success
 	modifySeatResponse := &pb.ModifySeatResponse{Res: "Seat modified successfully"}
@@ -281,32 +621,132 @@ func (s *Server) ModifySeat(ctx context.Context, req *pb.ModifySeatRequest) (*pb
 	return modifySeatResponse, nil
 }
 
+func (s *Server) ListBookings(ctx context.Context, req *pb.ListBookingsRequest) (*pb.ListBookingsResponse, error) {
+	// Default to the signed-in passenger's own bookings
+	email := req.GetEmail()
+	if c := claimsFrom(ctx); email == "" && c != nil && c.Role == rolePassenger {
+		email = c.Subject
+	}
+
+	// Validate the request
+	if email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Email cannot be empty")
+	}
+	if err := s.authorizeEmail(ctx, email); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// Create a ListBookingsResponse with every ticket held by the email
+	listBookingsResponse := &pb.ListBookingsResponse{
+		Receipts: s.refreshStatuses(s.store.ListByEmail(email)),
+	}
+
+	return listBookingsResponse, nil
+}
+
 func main() {
-    lis, err := net.Listen("tcp", ":8080")
-    if err != nil {
-        log.Fatalf("failed to listen: %v", err)
-    }
-
-    s := grpc.NewServer()
-    service := &Server{
-        userInfo: make(map[string]*pb.Receipt),
-    }
-    pb.RegisterTicketServiceServer(s, service)
-
-    go func() {
-        if err := s.Serve(lis); err != nil {
-            log.Fatalf("failed to serve: %v", err)
-        }
-    }()
-
-    log.Println("Server is running on :8080")
-
-    // Ctrl+C to stop the server
-    ch := make(chan os.Signal, 1)
-    signal.Notify(ch, os.Interrupt)
-    <-ch
-
-    log.Println("Stopping the Server...")
-    s.GracefulStop()
-    log.Println("Server stopped")
-}
\ No newline at end of file
+	cfg, printConfig, err := loadConfig(os.Args[0], os.Args[1:])
+	if err != nil {
+		log.Fatalf("invalid configuration: %v", err)
+	}
+	if printConfig {
+		if err := cfg.print(os.Stdout); err != nil {
+			log.Fatalf("failed to print configuration: %v", err)
+		}
+		return
+	}
+
+	var store storage
+	switch cfg.Store.Kind {
+	case "memory":
+		store = newMemoryStore()
+	case "file":
+		fileStore, err := openFileStore(cfg.Store.Path)
+		if err != nil {
+			log.Fatalf("failed to open booking store: %v", err)
+		}
+		store = fileStore
+	}
+	defer store.Close()
+
+	serverOptions := []grpc.ServerOption{}
+	if cfg.TLS.enabled() {
+		creds, err := cfg.TLS.credentials()
+		if err != nil {
+			log.Fatalf("failed to load TLS certificate: %v", err)
+		}
+		serverOptions = append(serverOptions, grpc.Creds(creds))
+	}
+
+	lis, err := net.Listen("tcp", cfg.Listen)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+
+	service := newServer(store, store, store, cfg.catalog)
+	service.holdTTL = cfg.Timeouts.HoldTTL
+	service.paymentTimeout = cfg.Timeouts.Payment
+	service.idempotency = newIdempotencyCache(cfg.Timeouts.IdempotencyWindow)
+	service.adminSecret = cfg.Auth.AdminSecret
+	secret := []byte(cfg.Auth.Secret)
+	if len(secret) == 0 {
+		log.Printf("no auth secret set; access tokens will stop working when the server restarts")
+		secret = randomSecret()
+	}
+	service.auth = newAuthenticator(secret, cfg.Auth.TokenTTL)
+
+	s := grpc.NewServer(append(serverOptions,
+		grpc.ChainUnaryInterceptor(service.authInterceptor, service.idempotencyInterceptor),
+		grpc.ChainStreamInterceptor(service.authStreamInterceptor),
+	)...)
+	pb.RegisterTicketServiceServer(s, service)
+	pb.RegisterTicketAdminServiceServer(s, &AdminServer{Server: service})
+
+	// Report readiness to load balancers, and describe the services to
+	// tools such as grpcurl
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+	reflection.Register(s)
+	stopHealthChecks := service.startHealthChecks(healthServer, healthCheckInterval)
+	defer stopHealthChecks()
+
+	stopReaper := service.startHoldReaper(holdReapInterval)
+	defer stopReaper()
+
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			log.Fatalf("failed to serve: %v", err)
+		}
+	}()
+
+	log.Printf("Server is running on %s", cfg.Listen)
+
+	// Serve TicketService as JSON over HTTP too
+	stopGateway := func() {}
+	if cfg.HTTPAddr != "" {
+		stopGateway, err = serveGateway(cfg.HTTPAddr, lis.Addr().String(), cfg.TLS)
+		if err != nil {
+			log.Fatalf("failed to start gateway: %v", err)
+		}
+		log.Printf("REST gateway is running on %s", cfg.HTTPAddr)
+	}
+
+	// Ctrl+C to stop the server
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt)
+	<-ch
+
+	log.Println("Stopping the Server...")
+	healthServer.Shutdown()
+	if cfg.Timeouts.DrainDelay > 0 {
+		log.Printf("Draining for %s...", cfg.Timeouts.DrainDelay)
+		time.Sleep(cfg.Timeouts.DrainDelay)
+	}
+	// Stop the gateway first, so its streams do not hold up the server
+	stopGateway()
+	s.GracefulStop()
+	log.Println("Server stopped")
+}
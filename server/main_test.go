@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+)
+
+// Helper function to make a server selling the default catalog from an
+// in-memory store
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	store := newMemoryStore()
+	return newServer(store, store, store, defaultCatalog())
+}
+
+// Helper function to get a context signed in as an admin
+func adminContext() context.Context {
+	return context.WithValue(context.Background(), claimsKey{}, &claims{Subject: roleAdmin, Role: roleAdmin})
+}
+
+// Helper function to get a context signed in as the passenger with email
+func passengerContext(email string) context.Context {
+	return context.WithValue(context.Background(), claimsKey{}, &claims{Subject: email, Role: rolePassenger})
+}
+
+// Helper function to buy a ticket between two stations of the default train
+func purchaseTicket(t *testing.T, s *Server, email, from, to string) *pb.PurchaseResponse {
+	t.Helper()
+	response, err := s.PurchaseTicket(context.Background(), &pb.PurchaseRequest{
+		From: from,
+		To:   to,
+		User: &pb.User{FirstName: "Test", LastName: "Passenger", Email: email},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket(%s, %s - %s): %v", email, from, to, err)
+	}
+	return response
+}
+
+// Helper function to fail the test if any seat is held by more than one
+// ticket on the same part of the route
+func checkNoDoubleBooking(t *testing.T, s *Server) {
+	t.Helper()
+	for _, section := range []string{"A", "B"} {
+		response, err := s.GetUsersBySection(adminContext(), &pb.GetUsersBySectionRequest{Section: section})
+		if err != nil {
+			t.Errorf("GetUsersBySection(%s): %v", section, err)
+			return
+		}
+		holders := make(map[int32][]*pb.Receipt)
+		for _, receipt := range response.UserInfo {
+			seat := receipt.GetSeat().GetSeatNumber()
+			train := s.catalog.Train(receipt.TrainId)
+			for _, other := range holders[seat] {
+				if receiptLeg(train, other).Overlaps(receiptLeg(train, receipt)) {
+					t.Errorf("seat %s%d is held by both %s and %s", section, seat, other.PurchaseId, receipt.PurchaseId)
+				}
+			}
+			holders[seat] = append(holders[seat], receipt)
+		}
+	}
+}
+
+func TestConcurrentSeatChangesNeverDoubleBook(t *testing.T) {
+	s := newTestServer(t)
+	ctx := adminContext()
+
+	// More passengers than seats, so they compete for them
+	const passengers = 30
+	purchaseIDs := make([]string, passengers)
+	for i := range purchaseIDs {
+		purchaseIDs[i] = purchaseTicket(t, s, fmt.Sprintf("p%d@example.com", i), "London", "Paris").PurchaseId
+	}
+
+	var wg sync.WaitGroup
+	for i, purchaseID := range purchaseIDs {
+		wg.Add(1)
+		go func(i int, purchaseID string) {
+			defer wg.Done()
+			section := []string{"A", "B"}[i%2]
+			if _, err := s.AllocateSeat(ctx, &pb.AllocateSeatRequest{PurchaseId: purchaseID, Section: section}); err != nil {
+				return
+			}
+			for move := 0; move < 5; move++ {
+				s.ModifySeat(ctx, &pb.ModifySeatRequest{
+					PurchaseId:    purchaseID,
+					NewSection:    []string{"A", "B"}[(i+move)%2],
+					NewSeatNumber: int32((i+move)%10 + 1),
+				})
+			}
+			if i%3 == 0 {
+				s.RemoveUser(ctx, &pb.RemoveUserRequest{PurchaseId: purchaseID})
+			}
+		}(i, purchaseID)
+	}
+
+	// Check while the seats change hands, not only at the end
+	done := make(chan struct{})
+	checked := make(chan struct{})
+	go func() {
+		defer close(checked)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				checkNoDoubleBooking(t, s)
+			}
+		}
+	}()
+
+	wg.Wait()
+	close(done)
+	<-checked
+	checkNoDoubleBooking(t, s)
+}
+
+func TestConcurrentAllocationsFillEachSeatOnce(t *testing.T) {
+	s := newTestServer(t)
+	ctx := adminContext()
+
+	// Ten seats in each section, and twelve passengers for each
+	const passengers = 24
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	seated := 0
+	for i := 0; i < passengers; i++ {
+		purchaseID := purchaseTicket(t, s, fmt.Sprintf("q%d@example.com", i), "London", "Paris").PurchaseId
+		section := []string{"A", "B"}[i%2]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := s.AllocateSeat(ctx, &pb.AllocateSeatRequest{PurchaseId: purchaseID, Section: section}); err == nil {
+				mu.Lock()
+				seated++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if seated != 20 {
+		t.Errorf("seated %d passengers, want 20", seated)
+	}
+	checkNoDoubleBooking(t, s)
+}
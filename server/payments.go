@@ -0,0 +1,254 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// How long the server waits for the payment provider on each call unless
+// told otherwise
+const defaultPaymentTimeout = 10 * time.Second
+
+// PaymentProvider takes payments for bookings. A purchase authorizes the
+// booking total and captures it once the authorization succeeds; if the
+// capture fails the authorization is voided. Cancellations refund part or
+// all of a captured charge.
+type PaymentProvider interface {
+	// Name identifies the provider on receipts.
+	Name() string
+	// Authorize reserves amount on the payment method behind token and
+	// returns a reference for the charge.
+	Authorize(ctx context.Context, charge PaymentCharge) (reference string, err error)
+	// Capture collects an authorized charge.
+	Capture(ctx context.Context, reference string) error
+	// Void releases an authorization that has not been captured.
+	Void(ctx context.Context, reference string) error
+	// Refund returns amount of a captured charge and returns a reference for
+	// the refund. A refund is made once per key: asking again with the same
+	// key returns the first refund's reference, so a refund whose outcome
+	// was lost can be retried.
+	Refund(ctx context.Context, reference, key string, amount *pb.Money) (refundReference string, err error)
+}
+
+// PaymentCharge is what a customer is asked to pay for a booking.
+type PaymentCharge struct {
+	BookingID string
+	Email     string
+	Token     string
+	Amount    *pb.Money
+}
+
+// PaymentDeclinedError is returned by a provider that refuses a payment.
+type PaymentDeclinedError struct {
+	Reason string
+}
+
+func (e *PaymentDeclinedError) Error() string {
+	return "payment declined: " + e.Reason
+}
+
+// Helper function to report a payment provider failure to the caller
+func paymentError(err error) error {
+	var declined *PaymentDeclinedError
+	switch {
+	case errors.As(err, &declined):
+		return status.Errorf(codes.FailedPrecondition, "Payment declined: %s", declined.Reason)
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Errorf(codes.DeadlineExceeded, "Payment provider did not respond in time")
+	default:
+		log.Printf("payment provider: %v", err)
+		return status.Errorf(codes.Unavailable, "Payment could not be processed")
+	}
+}
+
+// Payment tokens the fake provider treats specially, in the manner of a
+// card network's test card numbers. Any other token is approved.
+const (
+	fakeTokenDecline = "tok_decline"
+	fakeTokenTimeout = "tok_timeout"
+)
+
+// Operations of the fake provider that can be scripted
+const (
+	fakeAuthorize = "authorize"
+	fakeCapture   = "capture"
+	fakeVoid      = "void"
+	fakeRefund    = "refund"
+)
+
+// errFakeTimeout scripts a call that hangs until its context is done.
+var errFakeTimeout = errors.New("fake provider timeout")
+
+// fakePaymentProvider is an in-process PaymentProvider that approves every
+// payment unless told otherwise, either by the payment token or by failures
+// queued with Fail.
+type fakePaymentProvider struct {
+	mu       sync.Mutex
+	failures map[string][]error
+	charges  map[string]*fakeCharge
+}
+
+type fakeCharge struct {
+	amount   *pb.Money
+	captured bool
+	voided   bool
+	refunded int64
+	refunds  map[string]string
+}
+
+func newFakePaymentProvider() *fakePaymentProvider {
+	return &fakePaymentProvider{
+		failures: make(map[string][]error),
+		charges:  make(map[string]*fakeCharge),
+	}
+}
+
+// Fail makes the next call of operation return err. errFakeTimeout makes
+// it hang until its context is done instead.
+func (f *fakePaymentProvider) Fail(operation string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.failures[operation] = append(f.failures[operation], err)
+}
+
+// Helper function to play back the next scripted failure of an operation
+func (f *fakePaymentProvider) scripted(ctx context.Context, operation string) error {
+	f.mu.Lock()
+	queue := f.failures[operation]
+	if len(queue) == 0 {
+		f.mu.Unlock()
+		return nil
+	}
+	err := queue[0]
+	f.failures[operation] = queue[1:]
+	f.mu.Unlock()
+
+	if err == errFakeTimeout {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return err
+}
+
+func (f *fakePaymentProvider) Name() string {
+	return "fake"
+}
+
+func (f *fakePaymentProvider) Authorize(ctx context.Context, charge PaymentCharge) (string, error) {
+	switch charge.Token {
+	case fakeTokenDecline:
+		return "", &PaymentDeclinedError{Reason: "card declined"}
+	case fakeTokenTimeout:
+		<-ctx.Done()
+		return "", ctx.Err()
+	}
+	if err := f.scripted(ctx, fakeAuthorize); err != nil {
+		return "", err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	reference := "fake_" + uuid.New().String()
+	f.charges[reference] = &fakeCharge{amount: charge.Amount, refunds: make(map[string]string)}
+	return reference, nil
+}
+
+func (f *fakePaymentProvider) Capture(ctx context.Context, reference string) error {
+	if err := f.scripted(ctx, fakeCapture); err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	charge, ok := f.charges[reference]
+	if !ok || charge.voided {
+		return fmt.Errorf("capture %s: no such authorization", reference)
+	}
+	charge.captured = true
+	return nil
+}
+
+func (f *fakePaymentProvider) Void(ctx context.Context, reference string) error {
+	if err := f.scripted(ctx, fakeVoid); err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	charge, ok := f.charges[reference]
+	if !ok || charge.captured {
+		return fmt.Errorf("void %s: no uncaptured authorization", reference)
+	}
+	charge.voided = true
+	return nil
+}
+
+func (f *fakePaymentProvider) Refund(ctx context.Context, reference, key string, amount *pb.Money) (string, error) {
+	if err := f.scripted(ctx, fakeRefund); err != nil {
+		return "", err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	charge, ok := f.charges[reference]
+	if !ok || !charge.captured {
+		return "", fmt.Errorf("refund %s: no captured charge", reference)
+	}
+	if refundReference, ok := charge.refunds[key]; ok {
+		return refundReference, nil
+	}
+	if charge.refunded+amount.AmountMinor > charge.amount.AmountMinor {
+		return "", fmt.Errorf("refund %s: %d exceeds the amount left to refund", reference, amount.AmountMinor)
+	}
+	charge.refunded += amount.AmountMinor
+	charge.refunds[key] = "fake_refund_" + uuid.New().String()
+	return charge.refunds[key], nil
+}
+
+// Helper function to authorize and capture a booking, voiding the
+// authorization if the capture fails
+func (s *Server) charge(ctx context.Context, charge PaymentCharge) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.paymentTimeout)
+	defer cancel()
+
+	reference, err := s.payments.Authorize(ctx, charge)
+	if err != nil {
+		return "", err
+	}
+	if err := s.payments.Capture(ctx, reference); err != nil {
+		voidCtx, cancelVoid := context.WithTimeout(context.Background(), s.paymentTimeout)
+		defer cancelVoid()
+		if voidErr := s.payments.Void(voidCtx, reference); voidErr != nil {
+			log.Printf("payment provider: voiding %s after failed capture: %v", reference, voidErr)
+		}
+		return "", err
+	}
+	return reference, nil
+}
+
+// Helper function to refund a captured charge in full because the booking
+// it paid for was withdrawn: it could not be saved, or was changed while the
+// payment was taken. It must not be called with s.mu held, as it waits for
+// the provider.
+func (s *Server) refundWithdrawn(reference string, amount *pb.Money) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.paymentTimeout)
+	defer cancel()
+
+	if _, err := s.payments.Refund(ctx, reference, "withdrawn "+reference, amount); err != nil {
+		log.Printf("payment provider: refunding %s for a withdrawn booking: %v", reference, err)
+	}
+}
@@ -0,0 +1,307 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Payment token the gated provider holds until released, then declines
+// unless told to approve
+const gatedToken = "tok_gated"
+
+// gatedPaymentProvider is the fake provider, except that it stops payments
+// made with gatedToken until the test lets them carry on.
+type gatedPaymentProvider struct {
+	*fakePaymentProvider
+	entered chan struct{}
+	release chan struct{}
+	approve bool
+}
+
+func (g *gatedPaymentProvider) Authorize(ctx context.Context, charge PaymentCharge) (string, error) {
+	if charge.Token == gatedToken {
+		g.entered <- struct{}{}
+		<-g.release
+		if !g.approve {
+			return "", &PaymentDeclinedError{Reason: "card declined"}
+		}
+	}
+	return g.fakePaymentProvider.Authorize(ctx, charge)
+}
+
+// Helper function to create a promo code good for maxUses purchases
+func createPromo(t *testing.T, s *Server, code string, maxUses int32) {
+	t.Helper()
+	admin := &AdminServer{Server: s}
+	_, err := admin.CreatePromoCode(adminContext(), &pb.CreatePromoCodeRequest{
+		Promo: &pb.PromoCode{Code: code, PercentOff: 10, MaxUses: maxUses},
+	})
+	if err != nil {
+		t.Fatalf("CreatePromoCode: %v", err)
+	}
+}
+
+func TestDeclinedPaymentKeepsConcurrentPromoUses(t *testing.T) {
+	s := newTestServer(t)
+	gated := &gatedPaymentProvider{
+		fakePaymentProvider: newFakePaymentProvider(),
+		entered:             make(chan struct{}),
+		release:             make(chan struct{}),
+	}
+	s.payments = gated
+	createPromo(t, s, "TWICE", 2)
+
+	// The first purchase records its use and waits for the payment
+	declined := make(chan error)
+	go func() {
+		_, err := s.PurchaseTicket(context.Background(), &pb.PurchaseRequest{
+			From: "London", To: "Paris", PromoCode: "TWICE", PaymentToken: gatedToken,
+			User: &pb.User{FirstName: "Slow", LastName: "Payer", Email: "slow@example.com"},
+		})
+		declined <- err
+	}()
+	<-gated.entered
+
+	// Meanwhile another purchase uses the code too
+	_, err := s.PurchaseTicket(context.Background(), &pb.PurchaseRequest{
+		From: "London", To: "Paris", PromoCode: "TWICE",
+		User: &pb.User{FirstName: "Quick", LastName: "Payer", Email: "quick@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket with the promo code: %v", err)
+	}
+
+	close(gated.release)
+	if err := <-declined; status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("declined purchase: got %v, want FailedPrecondition", err)
+	}
+
+	// Only the declined purchase gives its use back
+	promo, _ := s.promos.GetPromo("TWICE")
+	if promo.Uses != 1 {
+		t.Fatalf("promo code has %d uses, want 1", promo.Uses)
+	}
+	if promo.UsesByEmail["quick@example.com"] != 1 || promo.UsesByEmail["slow@example.com"] != 0 {
+		t.Fatalf("promo code uses by email = %v, want only quick@example.com", promo.UsesByEmail)
+	}
+}
+
+// unpaidOnlyStore is an in-memory store that fails to save paid tickets.
+type unpaidOnlyStore struct {
+	*memoryStore
+}
+
+func (u unpaidOnlyStore) PutReceipt(receipt *pb.Receipt) error {
+	if receipt.Status == pb.TicketStatus_TICKET_STATUS_PURCHASED {
+		return errors.New("disk full")
+	}
+	return u.memoryStore.PutReceipt(receipt)
+}
+
+func TestUnsavedPaidBookingIsRefunded(t *testing.T) {
+	mem := newMemoryStore()
+	s := newServer(unpaidOnlyStore{mem}, mem, mem, defaultCatalog())
+	payments := newFakePaymentProvider()
+	s.payments = payments
+
+	_, err := s.PurchaseTicket(context.Background(), &pb.PurchaseRequest{
+		From: "London", To: "Paris",
+		User: &pb.User{FirstName: "Unlucky", LastName: "Payer", Email: "unlucky@example.com"},
+	})
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("PurchaseTicket with a failing store: got %v, want Internal", err)
+	}
+
+	if len(payments.charges) != 1 {
+		t.Fatalf("provider has %d charges, want 1", len(payments.charges))
+	}
+	for reference, charge := range payments.charges {
+		if !charge.captured || charge.refunded != charge.amount.AmountMinor {
+			t.Fatalf("charge %s: captured %v, refunded %d of %d, want a full refund", reference, charge.captured, charge.refunded, charge.amount.AmountMinor)
+		}
+	}
+	if receipts := mem.ListByEmail("unlucky@example.com"); len(receipts) != 0 {
+		t.Fatalf("%d tickets left behind for the unsaved booking", len(receipts))
+	}
+}
+
+func TestBookingChangedDuringPaymentIsRefunded(t *testing.T) {
+	s := newTestServer(t)
+	fake := newFakePaymentProvider()
+	gated := &gatedPaymentProvider{
+		fakePaymentProvider: fake,
+		entered:             make(chan struct{}),
+		release:             make(chan struct{}),
+		approve:             true,
+	}
+	s.payments = gated
+
+	// The purchase waits for the payment with two tickets awaiting it
+	aborted := make(chan error)
+	go func() {
+		_, err := s.PurchaseTicket(context.Background(), &pb.PurchaseRequest{
+			From: "London", To: "Paris", PaymentToken: gatedToken,
+			User: &pb.User{FirstName: "Slow", LastName: "Payer", Email: "slow@example.com"},
+			Passengers: []*pb.User{
+				{FirstName: "Slow", LastName: "Payer"},
+				{FirstName: "Other", LastName: "Payer", Email: "other@example.com"},
+			},
+		})
+		aborted <- err
+	}()
+	<-gated.entered
+
+	// Meanwhile one of them is taken out of the booking
+	s.mu.Lock()
+	removed := s.store.ListByEmail("other@example.com")[0]
+	removed.Status = pb.TicketStatus_TICKET_STATUS_CANCELLED
+	removed.Cancellation = &pb.Cancellation{Reason: "Removed by an operator"}
+	if err := s.store.PutReceipt(removed); err != nil {
+		t.Fatalf("PutReceipt: %v", err)
+	}
+	s.mu.Unlock()
+
+	close(gated.release)
+	if err := <-aborted; status.Code(err) != codes.Aborted {
+		t.Fatalf("purchase of a changed booking: got %v, want Aborted", err)
+	}
+
+	// The change stands, the rest of the booking is withdrawn and the
+	// payment is given back
+	stored, ok := s.store.GetReceipt(removed.PurchaseId)
+	if !ok || stored.Status != pb.TicketStatus_TICKET_STATUS_CANCELLED {
+		t.Fatalf("removed ticket is now %v, want it still cancelled", stored.GetStatus())
+	}
+	if receipts := s.store.ListByEmail("slow@example.com"); len(receipts) != 0 {
+		t.Fatalf("%d tickets kept for the changed booking", len(receipts))
+	}
+	if len(fake.charges) != 1 {
+		t.Fatalf("provider has %d charges, want 1", len(fake.charges))
+	}
+	for reference, charge := range fake.charges {
+		if !charge.captured || charge.refunded != charge.amount.AmountMinor {
+			t.Fatalf("charge %s: captured %v, refunded %d of %d, want a full refund", reference, charge.captured, charge.refunded, charge.amount.AmountMinor)
+		}
+	}
+}
+
+// Helper function to buy a ticket paying with token, returning the error
+func purchaseWithToken(s *Server, email, token string) (*pb.PurchaseResponse, error) {
+	return s.PurchaseTicket(context.Background(), &pb.PurchaseRequest{
+		From: "London", To: "Paris", PaymentToken: token,
+		User: &pb.User{FirstName: "Test", LastName: "Payer", Email: email},
+	})
+}
+
+func TestFakeProviderDeclines(t *testing.T) {
+	s := newTestServer(t)
+
+	_, err := purchaseWithToken(s, "declined@example.com", fakeTokenDecline)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("PurchaseTicket with %s: got %v, want FailedPrecondition", fakeTokenDecline, err)
+	}
+	if receipts := s.store.ListByEmail("declined@example.com"); len(receipts) != 0 {
+		t.Fatalf("%d tickets kept for a declined payment", len(receipts))
+	}
+}
+
+func TestFakeProviderTimesOut(t *testing.T) {
+	s := newTestServer(t)
+	s.paymentTimeout = 10 * time.Millisecond
+
+	_, err := purchaseWithToken(s, "slow@example.com", fakeTokenTimeout)
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("PurchaseTicket with %s: got %v, want DeadlineExceeded", fakeTokenTimeout, err)
+	}
+	if receipts := s.store.ListByEmail("slow@example.com"); len(receipts) != 0 {
+		t.Fatalf("%d tickets kept for a payment that timed out", len(receipts))
+	}
+}
+
+func TestFakeProviderPlaysBackQueuedFailures(t *testing.T) {
+	s := newTestServer(t)
+	payments := newFakePaymentProvider()
+	s.payments = payments
+	s.paymentTimeout = 10 * time.Millisecond
+
+	// Failures are used up in order, one per call
+	payments.Fail(fakeAuthorize, errors.New("provider down"))
+	payments.Fail(fakeAuthorize, errFakeTimeout)
+	payments.Fail(fakeCapture, &PaymentDeclinedError{Reason: "insufficient funds"})
+
+	if _, err := purchaseWithToken(s, "first@example.com", ""); status.Code(err) != codes.Unavailable {
+		t.Fatalf("first purchase: got %v, want Unavailable", err)
+	}
+	if _, err := purchaseWithToken(s, "second@example.com", ""); status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("second purchase: got %v, want DeadlineExceeded", err)
+	}
+
+	// A failed capture voids the authorization
+	if _, err := purchaseWithToken(s, "third@example.com", ""); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("third purchase: got %v, want FailedPrecondition", err)
+	}
+	if len(payments.charges) != 1 {
+		t.Fatalf("provider has %d charges, want 1", len(payments.charges))
+	}
+	for reference, charge := range payments.charges {
+		if charge.captured || !charge.voided {
+			t.Fatalf("charge %s: captured %v, voided %v, want only voided", reference, charge.captured, charge.voided)
+		}
+	}
+
+	// With the queue empty, payments go through again
+	response, err := purchaseWithToken(s, "fourth@example.com", "")
+	if err != nil {
+		t.Fatalf("fourth purchase: %v", err)
+	}
+	if !payments.charges[response.PaymentReference].captured {
+		t.Fatalf("charge %s was not captured", response.PaymentReference)
+	}
+}
+
+func TestPendingRefundIsRetriedOnce(t *testing.T) {
+	s := newTestServer(t)
+	payments := newFakePaymentProvider()
+	s.payments = payments
+	ctx := adminContext()
+
+	response, err := purchaseWithToken(s, "refund@example.com", "")
+	if err != nil {
+		t.Fatalf("PurchaseTicket: %v", err)
+	}
+
+	// The server stops after the refund reached the provider but before
+	// its outcome was saved, leaving the ticket with its refund pending
+	s.mu.Lock()
+	receipt, _ := s.store.GetReceipt(response.PurchaseId)
+	err = s.cancelTicket(receipt, "Changed plans")
+	s.mu.Unlock()
+	if err != nil {
+		t.Fatalf("cancelTicket: %v", err)
+	}
+	refund := receipt.Cancellation.GetRefund()
+	if receipt.Payment.GetState() != pb.PaymentState_PAYMENT_STATE_REFUND_PENDING || refund.GetAmountMinor() <= 0 {
+		t.Fatalf("cancelled ticket: payment %v, refund %v, want a pending refund", receipt.Payment.GetState(), refund)
+	}
+	if _, err := payments.Refund(context.Background(), receipt.Payment.Reference, receipt.PurchaseId, refund); err != nil {
+		t.Fatalf("Refund: %v", err)
+	}
+
+	// Cancelling again finishes the refund without paying it twice
+	cancelled, err := s.CancelTicket(ctx, &pb.CancelTicketRequest{PurchaseId: response.PurchaseId})
+	if err != nil {
+		t.Fatalf("CancelTicket retrying the refund: %v", err)
+	}
+	if state := cancelled.Receipt.Payment.GetState(); state != pb.PaymentState_PAYMENT_STATE_REFUNDED {
+		t.Fatalf("payment is %v after the retry, want refunded", state)
+	}
+	if charge := payments.charges[receipt.Payment.Reference]; charge.refunded != refund.AmountMinor {
+		t.Fatalf("provider refunded %d, want %d once", charge.refunded, refund.AmountMinor)
+	}
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// How much each preference counts when choosing a seat. Each weighs more
+// than all the ones below it together, so sitting beside a companion beats
+// any combination of the others, then window or aisle, and so on.
+const (
+	weightNextTo   = 16
+	weightPosition = 8
+	weightQuiet    = 4
+	weightForward  = 2
+	weightNearExit = 1
+)
+
+// sectionSeat is a seat together with the section it is in.
+type sectionSeat struct {
+	section *SectionLayout
+	seat    SeatLayout
+}
+
+// seatChoice is a seat rated against a passenger's preferences.
+type seatChoice struct {
+	sectionSeat
+	score  int
+	met    []string
+	missed []string
+}
+
+// Helper function to rate a seat against preferences. neighbour is the
+// seat of the passenger to sit beside, or nil if they have none.
+func rateSeat(prefs *pb.SeatPreferences, seat sectionSeat, neighbour *sectionSeat) seatChoice {
+	choice := seatChoice{sectionSeat: seat}
+	check := func(name string, weight int, met bool) {
+		if met {
+			choice.score += weight
+			choice.met = append(choice.met, name)
+		} else {
+			choice.missed = append(choice.missed, name)
+		}
+	}
+
+	switch prefs.GetPosition() {
+	case pb.SeatPosition_SEAT_POSITION_WINDOW:
+		check("window", weightPosition, seat.seat.Window)
+	case pb.SeatPosition_SEAT_POSITION_AISLE:
+		check("aisle", weightPosition, seat.seat.Aisle)
+	}
+	if prefs.GetForwardFacing() {
+		check("forward_facing", weightForward, seat.seat.Forward)
+	}
+	if prefs.GetNearExit() {
+		check("near_exit", weightNearExit, seat.seat.NearExit)
+	}
+	if prefs.GetQuietCoach() {
+		check("quiet_coach", weightQuiet, seat.section.Quiet)
+	}
+	if prefs.GetNextToPurchaseId() != "" {
+		check("next_to", weightNextTo, neighbour != nil && neighbour.section == seat.section && neighbour.seat.NextTo(seat.seat))
+	}
+	return choice
+}
+
+// Helper function to list the sections to allocate seats from: the one
+// asked for or, when only preferences are given, every section of the
+// ticket's class
+func allocationSections(train *Train, ticket *pb.Receipt, name string, prefs *pb.SeatPreferences) ([]*SectionLayout, error) {
+	if name != "" {
+		section := train.Section(name)
+		if section == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid section: %s (available: %s)", name, train.SectionNames())
+		}
+		if err := checkSeatClass(ticket, section); err != nil {
+			return nil, err
+		}
+		return []*SectionLayout{section}, nil
+	}
+
+	if prefs == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Section cannot be empty")
+	}
+	var sections []*SectionLayout
+	for _, section := range train.Sections {
+		if checkSeatClass(ticket, section) == nil {
+			sections = append(sections, section)
+		}
+	}
+	if len(sections) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Train %s has no %s class section", train.ID, ticket.SeatClass)
+	}
+	return sections, nil
+}
+
+// Helper function to find the seat of the passenger a ticket should sit
+// beside. It is nil if no neighbour was asked for or they have no seat
+// yet. The neighbour must be a ticket the caller may act on or one of the
+// same booking; any other reads as not found, so nothing is learnt about
+// it. Callers must hold s.mu.
+func (s *Server) preferredNeighbour(ctx context.Context, train *Train, ticket *pb.Receipt, purchaseID string) (*sectionSeat, error) {
+	if purchaseID == "" {
+		return nil, nil
+	}
+	neighbour, err := s.lookupTicket(purchaseID, "")
+	if err == nil && (ticket.BookingId == "" || neighbour.BookingId != ticket.BookingId) {
+		err = s.authorizeTicket(ctx, neighbour)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Purchase not found: %s", purchaseID)
+	}
+	if receiptDeparture(neighbour) != receiptDeparture(ticket) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot sit next to purchase %s: it is for a different train", purchaseID)
+	}
+
+	key, seated := receiptSeat(neighbour)
+	if !seated {
+		return nil, nil
+	}
+	section := train.Section(key.section)
+	if section == nil || !section.HasSeat(key.seatNumber) {
+		return nil, nil
+	}
+	return &sectionSeat{section: section, seat: section.Seats()[key.seatNumber-1]}, nil
+}
+
+// Helper function to pick count seats free for the whole of leg, each the
+// free seat best matching the preferences. Ties go to the earlier section
+// and the lower seat number. Callers must hold s.mu.
+func (s *Server) chooseSeats(train *Train, departure Departure, leg Leg, sections []*SectionLayout, prefs *pb.SeatPreferences, neighbour *sectionSeat, count int) ([]seatChoice, bool) {
+	taken := make(map[seatKey]bool)
+	choices := make([]seatChoice, 0, count)
+	for len(choices) < count {
+		var best *seatChoice
+		for _, section := range sections {
+			for _, seat := range section.Seats() {
+				key := seatKey{departure: departure, section: section.Name, seatNumber: seat.Number}
+				if taken[key] || !s.seatFree(train, departure, section.Name, seat.Number, leg) {
+					continue
+				}
+				choice := rateSeat(prefs, sectionSeat{section: section, seat: seat}, neighbour)
+				if best == nil || choice.score > best.score {
+					best = &choice
+				}
+			}
+		}
+		if best == nil {
+			return nil, false
+		}
+		taken[seatKey{departure: departure, section: best.section.Name, seatNumber: best.seat.Number}] = true
+		choices = append(choices, *best)
+	}
+	return choices, true
+}
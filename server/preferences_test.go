@@ -0,0 +1,54 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNextToOnlyFindsTicketsTheCallerMayUse(t *testing.T) {
+	s := newTestServer(t)
+	ctx := passengerContext("jo@example.com")
+
+	mine := purchaseTicket(t, s, "jo@example.com", "London", "Paris")
+	stranger := purchaseTicket(t, s, "stranger@example.com", "London", "Brussels")
+	if _, err := s.AllocateSeat(adminContext(), &pb.AllocateSeatRequest{PurchaseId: stranger.PurchaseId, Section: "A"}); err != nil {
+		t.Fatalf("AllocateSeat for the stranger: %v", err)
+	}
+
+	// Someone else's ticket reads the same as one that does not exist
+	for _, purchaseID := range []string{stranger.PurchaseId, "no-such-purchase"} {
+		_, err := s.AllocateSeat(ctx, &pb.AllocateSeatRequest{
+			PurchaseId:  mine.PurchaseId,
+			Preferences: &pb.SeatPreferences{NextToPurchaseId: purchaseID},
+		})
+		if status.Code(err) != codes.NotFound || status.Convert(err).Message() != "Purchase not found: "+purchaseID {
+			t.Fatalf("AllocateSeat next to %s: got %v, want Purchase not found", purchaseID, err)
+		}
+	}
+
+	// A ticket of the same booking can be sat beside
+	group, err := s.PurchaseTicket(ctx, &pb.PurchaseRequest{
+		From: "London", To: "Paris",
+		User: &pb.User{FirstName: "Jo", LastName: "Doe", Email: "jo@example.com"},
+		Passengers: []*pb.User{
+			{FirstName: "Jo", LastName: "Doe"},
+			{FirstName: "Sam", LastName: "Doe", Email: "sam@example.com"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket for a group: %v", err)
+	}
+	if _, err := s.AllocateSeat(ctx, &pb.AllocateSeatRequest{PurchaseId: group.Tickets[0].PurchaseId, Section: "B"}); err != nil {
+		t.Fatalf("AllocateSeat for the booker: %v", err)
+	}
+	_, err = s.AllocateSeat(passengerContext("sam@example.com"), &pb.AllocateSeatRequest{
+		PurchaseId:  group.Tickets[1].PurchaseId,
+		Preferences: &pb.SeatPreferences{NextToPurchaseId: group.Tickets[0].PurchaseId},
+	})
+	if err != nil {
+		t.Fatalf("AllocateSeat next to the booker: %v", err)
+	}
+}
@@ -0,0 +1,339 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+)
+
+// Pricing holds the fare rules of the catalog. Amounts are in minor units of
+// Currency and adjustments are whole percentages, so every fare is computed
+// in integer arithmetic.
+//
+// A fare is built up as:
+//
+//	base fare + distance fare (PerKm for every km of the leg)
+//	+ class supplement (Classes[class] percent of the above, minus 100)
+//	+ peak surcharge (if boarding inside a peak window)
+//	- advance purchase discount (the best rule the purchase qualifies for)
+//
+// Cancelling a ticket refunds the price paid less a fee set by the
+// Cancellation rule for the notice given.
+type Pricing struct {
+	Currency     string             `yaml:"currency"`
+	BaseFare     int64              `yaml:"base_fare"`
+	PerKm        int64              `yaml:"per_km"`
+	Classes      map[string]int     `yaml:"classes"`
+	Peak         PeakRule           `yaml:"peak"`
+	Advance      []AdvanceRule      `yaml:"advance"`
+	Cancellation []CancellationRule `yaml:"cancellation"`
+}
+
+// PeakRule adds SurchargePercent to journeys that board inside a window.
+type PeakRule struct {
+	SurchargePercent int          `yaml:"surcharge_percent"`
+	Windows          []PeakWindow `yaml:"windows"`
+}
+
+// PeakWindow is a daily time range, on the listed days or every day.
+type PeakWindow struct {
+	Days  []string `yaml:"days"`
+	Start string   `yaml:"start"`
+	End   string   `yaml:"end"`
+
+	days       map[time.Weekday]bool
+	start, end time.Duration
+}
+
+// AdvanceRule discounts tickets bought at least Days before departure.
+type AdvanceRule struct {
+	Days            int `yaml:"days"`
+	DiscountPercent int `yaml:"discount_percent"`
+}
+
+// CancellationRule keeps FeePercent of the price when a ticket is cancelled
+// at least HoursBefore hours before departure.
+type CancellationRule struct {
+	HoursBefore int `yaml:"hours_before"`
+	FeePercent  int `yaml:"fee_percent"`
+}
+
+// Number of digits after the decimal point for currencies that differ from
+// the usual two
+var currencyExponents = map[string]int{
+	"JPY": 0,
+	"KRW": 0,
+	"BHD": 3,
+	"KWD": 3,
+}
+
+// defaultPricing is used when the catalog does not define fares.
+func defaultPricing() *Pricing {
+	return &Pricing{
+		Currency: "GBP",
+		BaseFare: 500,
+		PerKm:    3,
+		Classes:  map[string]int{"standard": 100, "first": 180},
+		Peak: PeakRule{
+			SurchargePercent: 25,
+			Windows: []PeakWindow{
+				{Days: []string{"mon", "tue", "wed", "thu", "fri"}, Start: "06:30", End: "09:30"},
+				{Days: []string{"mon", "tue", "wed", "thu", "fri"}, Start: "16:00", End: "19:00"},
+			},
+		},
+		Advance: []AdvanceRule{
+			{Days: 30, DiscountPercent: 20},
+			{Days: 7, DiscountPercent: 10},
+		},
+		Cancellation: defaultCancellationRules(),
+	}
+}
+
+// defaultCancellationRules apply when the pricing does not set any: free up
+// to a week before departure, then a quarter and finally half of the price.
+func defaultCancellationRules() []CancellationRule {
+	return []CancellationRule{
+		{HoursBefore: 168, FeePercent: 0},
+		{HoursBefore: 24, FeePercent: 25},
+		{HoursBefore: 0, FeePercent: 50},
+	}
+}
+
+// validate checks the fare rules against the seat classes sold by the
+// catalog.
+func (p *Pricing) validate(classes map[string]bool) error {
+	p.Currency = strings.ToUpper(p.Currency)
+	if len(p.Currency) != 3 {
+		return fmt.Errorf("currency must be a three letter ISO 4217 code, got %q", p.Currency)
+	}
+	if p.BaseFare < 0 || p.PerKm < 0 {
+		return fmt.Errorf("base_fare and per_km cannot be negative")
+	}
+
+	if p.Classes == nil {
+		p.Classes = make(map[string]int)
+	}
+	if _, ok := p.Classes["standard"]; !ok {
+		p.Classes["standard"] = 100
+	}
+	for class, percent := range p.Classes {
+		if percent < 1 {
+			return fmt.Errorf("class %s must cost a positive percentage of the fare", class)
+		}
+	}
+	for class := range classes {
+		if _, ok := p.Classes[class]; !ok {
+			return fmt.Errorf("no fare defined for seat class %s", class)
+		}
+	}
+
+	if p.Peak.SurchargePercent < 0 {
+		return fmt.Errorf("peak surcharge cannot be negative")
+	}
+	for i := range p.Peak.Windows {
+		window := &p.Peak.Windows[i]
+		start, err := time.Parse("15:04", window.Start)
+		if err != nil {
+			return fmt.Errorf("peak window start must be HH:MM, got %q", window.Start)
+		}
+		end, err := time.Parse("15:04", window.End)
+		if err != nil {
+			return fmt.Errorf("peak window end must be HH:MM, got %q", window.End)
+		}
+		window.start = time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute
+		window.end = time.Duration(end.Hour())*time.Hour + time.Duration(end.Minute())*time.Minute
+		if window.end <= window.start {
+			return fmt.Errorf("peak window %s-%s ends before it starts", window.Start, window.End)
+		}
+		window.days = make(map[time.Weekday]bool)
+		for _, day := range window.Days {
+			weekday, ok := weekdays[strings.ToLower(day)]
+			if !ok {
+				return fmt.Errorf("unknown day %q in peak window", day)
+			}
+			window.days[weekday] = true
+		}
+	}
+
+	for _, rule := range p.Advance {
+		if rule.Days < 1 || rule.DiscountPercent < 1 || rule.DiscountPercent > 100 {
+			return fmt.Errorf("advance rules need days >= 1 and a discount of 1-100 percent")
+		}
+	}
+
+	if len(p.Cancellation) == 0 {
+		p.Cancellation = defaultCancellationRules()
+	}
+	for _, rule := range p.Cancellation {
+		if rule.HoursBefore < 0 || rule.FeePercent < 0 || rule.FeePercent > 100 {
+			return fmt.Errorf("cancellation rules need hours_before >= 0 and a fee of 0-100 percent")
+		}
+	}
+	return nil
+}
+
+// Helper function to report whether a boarding time falls in a peak window
+func (p *Pricing) isPeak(boarding time.Time) (bool, *PeakWindow) {
+	clock := time.Duration(boarding.Hour())*time.Hour + time.Duration(boarding.Minute())*time.Minute
+	for i := range p.Peak.Windows {
+		window := &p.Peak.Windows[i]
+		if len(window.days) > 0 && !window.days[boarding.Weekday()] {
+			continue
+		}
+		if clock >= window.start && clock < window.end {
+			return true, window
+		}
+	}
+	return false, nil
+}
+
+// Helper function to take a whole percentage of an amount, rounding half
+// away from zero
+func percentOf(amount int64, percent int) int64 {
+	product := amount * int64(percent)
+	if product < 0 {
+		return -((-product + 50) / 100)
+	}
+	return (product + 50) / 100
+}
+
+// Fare is an itemized price. Total is the sum of the amounts of Lines.
+type Fare struct {
+	Lines    []*pb.FareLine
+	Total    *pb.Money
+	Discount *pb.Discount
+}
+
+// Copy returns a fare that can be changed without changing f.
+func (f *Fare) Copy() *Fare {
+	return &Fare{
+		Lines:    append([]*pb.FareLine(nil), f.Lines...),
+		Total:    f.Total,
+		Discount: f.Discount,
+	}
+}
+
+// ApplyDiscount takes discount off the fare and records it as a fare line.
+func (f *Fare) ApplyDiscount(discount *pb.Discount) {
+	f.Lines = append(f.Lines, &pb.FareLine{
+		Description: fmt.Sprintf("Promo code %s: %s", discount.Code, discount.Description),
+		Amount:      &pb.Money{AmountMinor: -discount.Amount.AmountMinor, Currency: discount.Amount.Currency},
+	})
+	f.Total = &pb.Money{AmountMinor: f.Total.AmountMinor - discount.Amount.AmountMinor, Currency: f.Total.Currency}
+	f.Discount = discount
+}
+
+// SumLines adds up the lines of fares by description, in the order they
+// first appear, giving the breakdown of a booking of several tickets.
+func SumLines(fares []*Fare) []*pb.FareLine {
+	var lines []*pb.FareLine
+	byDescription := make(map[string]*pb.FareLine)
+	for _, fare := range fares {
+		for _, line := range fare.Lines {
+			sum, ok := byDescription[line.Description]
+			if !ok {
+				sum = &pb.FareLine{Description: line.Description, Amount: &pb.Money{Currency: line.Amount.Currency}}
+				byDescription[line.Description] = sum
+				lines = append(lines, sum)
+			}
+			sum.Amount.AmountMinor += line.Amount.AmountMinor
+		}
+	}
+	return lines
+}
+
+// Money returns amount as a Money message in the pricing currency.
+func (p *Pricing) Money(amount int64) *pb.Money {
+	return &pb.Money{AmountMinor: amount, Currency: p.Currency}
+}
+
+// Quote itemizes the fare for travelling from one station to another in
+// class on the run of train that leaves its origin on date, bought at now.
+// Callers must have checked that the train serves the stations.
+func (p *Pricing) Quote(train *Train, date time.Time, from, to, class string, now time.Time) (*Fare, error) {
+	classPercent, ok := p.Classes[class]
+	if !ok {
+		return nil, fmt.Errorf("unknown seat class %s", class)
+	}
+
+	var lines []*pb.FareLine
+	var total int64
+	add := func(description string, amount int64) {
+		lines = append(lines, &pb.FareLine{Description: description, Amount: p.Money(amount)})
+		total += amount
+	}
+
+	add("Base fare", p.BaseFare)
+
+	km := train.Stops[train.StopIndex(to)].Km - train.Stops[train.StopIndex(from)].Km
+	if p.PerKm > 0 && km > 0 {
+		add(fmt.Sprintf("Distance %s - %s (%d km)", from, to, km), p.PerKm*int64(km))
+	}
+
+	if classPercent != 100 {
+		add(fmt.Sprintf("%s%s class (%+d%%)", strings.ToUpper(class[:1]), class[1:], classPercent-100), percentOf(total, classPercent-100))
+	}
+
+	boarding := train.StopTime(date, from)
+	if peak, window := p.isPeak(boarding); peak && p.Peak.SurchargePercent > 0 {
+		add(fmt.Sprintf("Peak surcharge %s-%s (+%d%%)", window.Start, window.End, p.Peak.SurchargePercent), percentOf(total, p.Peak.SurchargePercent))
+	}
+
+	daysAhead := int(boarding.Sub(now) / (24 * time.Hour))
+	var best *AdvanceRule
+	for i := range p.Advance {
+		rule := &p.Advance[i]
+		if daysAhead >= rule.Days && (best == nil || rule.DiscountPercent > best.DiscountPercent) {
+			best = rule
+		}
+	}
+	if best != nil {
+		add(fmt.Sprintf("Advance purchase, %d+ days (-%d%%)", best.Days, best.DiscountPercent), -percentOf(total, best.DiscountPercent))
+	}
+
+	return &Fare{Lines: lines, Total: p.Money(total)}, nil
+}
+
+// CancellationFee works out what is kept of price when a ticket is
+// cancelled at now for a train boarding at departure: the fee of the rule
+// with the most notice that now still gives. Tickets cancelled with less
+// notice than every rule requires, or after departure, are not refunded.
+func (p *Pricing) CancellationFee(price *pb.Money, departure, now time.Time) (fee *pb.Money, description string) {
+	amount := price.GetAmountMinor()
+	notice := departure.Sub(now)
+	if notice < 0 {
+		return &pb.Money{AmountMinor: amount, Currency: price.GetCurrency()}, "No refund after departure"
+	}
+
+	hoursBefore := int(notice / time.Hour)
+	var best *CancellationRule
+	for i := range p.Cancellation {
+		rule := &p.Cancellation[i]
+		if hoursBefore >= rule.HoursBefore && (best == nil || rule.HoursBefore > best.HoursBefore) {
+			best = rule
+		}
+	}
+
+	if best == nil {
+		return &pb.Money{AmountMinor: amount, Currency: price.GetCurrency()}, "No refund this close to departure"
+	}
+	if best.HoursBefore == 0 {
+		description = fmt.Sprintf("Cancelled before departure (%d%% fee)", best.FeePercent)
+	} else {
+		description = fmt.Sprintf("Cancelled %d+ hours before departure (%d%% fee)", best.HoursBefore, best.FeePercent)
+	}
+	return &pb.Money{AmountMinor: percentOf(amount, best.FeePercent), Currency: price.GetCurrency()}, description
+}
+
+// MajorUnits converts a Money amount to a decimal number of whole currency
+// units, for the deprecated floating point price fields.
+func MajorUnits(money *pb.Money) float64 {
+	exponent, ok := currencyExponents[money.GetCurrency()]
+	if !ok {
+		exponent = 2
+	}
+	return float64(money.GetAmountMinor()) / math.Pow10(exponent)
+}
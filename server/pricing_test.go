@@ -0,0 +1,104 @@
+package main
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+)
+
+func TestQuote(t *testing.T) {
+	catalog := defaultCatalog()
+	pricing, train := catalog.Pricing, catalog.Train("T1")
+	saturday := time.Date(2024, 6, 8, 0, 0, 0, 0, time.Local)
+	monday := time.Date(2024, 6, 10, 0, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name      string
+		date      time.Time
+		from, to  string
+		class     string
+		bought    time.Time
+		wantLines []string
+		wantTotal int64
+	}{
+		{"off peak", saturday, "London", "Paris", "standard", saturday.Add(6 * time.Hour),
+			[]string{"Base fare", "Distance London - Paris (492 km)"}, 1976},
+		{"peak", monday, "London", "Paris", "standard", monday.Add(6 * time.Hour),
+			[]string{"Base fare", "Distance London - Paris (492 km)", "Peak surcharge 06:30-09:30 (+25%)"}, 2470},
+		{"boarding after the peak", monday, "Paris", "Brussels", "standard", monday.Add(6 * time.Hour),
+			[]string{"Base fare", "Distance Paris - Brussels (310 km)"}, 1430},
+		{"first class", saturday, "London", "Paris", "first", saturday.Add(6 * time.Hour),
+			[]string{"Base fare", "Distance London - Paris (492 km)", "First class (+80%)"}, 3557},
+		{"a week ahead", saturday, "London", "Paris", "standard", saturday.AddDate(0, 0, -8),
+			[]string{"Base fare", "Distance London - Paris (492 km)", "Advance purchase, 7+ days (-10%)"}, 1778},
+		{"a month ahead", saturday, "London", "Paris", "standard", saturday.AddDate(0, 0, -38),
+			[]string{"Base fare", "Distance London - Paris (492 km)", "Advance purchase, 30+ days (-20%)"}, 1581},
+		{"everything", monday, "London", "Paris", "first", monday.AddDate(0, 0, -31),
+			[]string{"Base fare", "Distance London - Paris (492 km)", "First class (+80%)", "Peak surcharge 06:30-09:30 (+25%)", "Advance purchase, 30+ days (-20%)"}, 3557},
+	}
+	for _, tt := range tests {
+		fare, err := pricing.Quote(train, tt.date, tt.from, tt.to, tt.class, tt.bought)
+		if err != nil {
+			t.Fatalf("%s: Quote: %v", tt.name, err)
+		}
+		if fare.Total.AmountMinor != tt.wantTotal || fare.Total.Currency != "GBP" {
+			t.Errorf("%s: got total %d %s, want %d GBP", tt.name, fare.Total.AmountMinor, fare.Total.Currency, tt.wantTotal)
+		}
+		var sum int64
+		var descriptions []string
+		for _, line := range fare.Lines {
+			sum += line.Amount.AmountMinor
+			descriptions = append(descriptions, line.Description)
+		}
+		if sum != fare.Total.AmountMinor {
+			t.Errorf("%s: lines add up to %d, total is %d", tt.name, sum, fare.Total.AmountMinor)
+		}
+		if !slices.Equal(descriptions, tt.wantLines) {
+			t.Errorf("%s: got lines %q, want %q", tt.name, descriptions, tt.wantLines)
+		}
+	}
+
+	if _, err := pricing.Quote(train, saturday, "London", "Paris", "sleeper", saturday); err == nil {
+		t.Fatal("Quote for an unknown class succeeded")
+	}
+}
+
+func TestCancellationFee(t *testing.T) {
+	pricing := defaultPricing()
+	departure := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	price := pricing.Money(10000)
+
+	tests := []struct {
+		notice  time.Duration
+		wantFee int64
+	}{
+		{8 * 24 * time.Hour, 0},
+		{168 * time.Hour, 0},
+		{168*time.Hour - time.Minute, 2500},
+		{24 * time.Hour, 2500},
+		{24*time.Hour - time.Minute, 5000},
+		{59 * time.Minute, 5000},
+		{0, 5000},
+		{-30 * time.Minute, 10000},
+		{-2 * time.Hour, 10000},
+	}
+	for _, tt := range tests {
+		fee, description := pricing.CancellationFee(price, departure, departure.Add(-tt.notice))
+		if fee.AmountMinor != tt.wantFee || fee.Currency != price.Currency {
+			t.Errorf("cancelled %v before departure: got fee %d %s (%s), want %d %s", tt.notice, fee.AmountMinor, fee.Currency, description, tt.wantFee, price.Currency)
+		}
+	}
+}
+
+func TestCancellationFeeWithoutRules(t *testing.T) {
+	pricing := defaultPricing()
+	pricing.Cancellation = nil
+	departure := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	fee, _ := pricing.CancellationFee(&pb.Money{AmountMinor: 1234, Currency: pricing.Currency}, departure, departure.Add(-30*24*time.Hour))
+	if fee.AmountMinor != 1234 {
+		t.Fatalf("fee without cancellation rules is %d, want the whole price", fee.AmountMinor)
+	}
+}
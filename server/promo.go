@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var promoCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+// Helper function to put a promo code in the form it is stored under
+func normalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Helper function to parse an optional RFC 3339 time
+func parseOptionalTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+// Helper function to check whether a promo code can be used at now by
+// email. An empty email skips the per-email limit.
+func checkPromo(promo *pb.PromoCode, email string, now time.Time) error {
+	if promo.Disabled {
+		return status.Errorf(codes.FailedPrecondition, "Promo code %s is no longer available", promo.Code)
+	}
+
+	validFrom, _ := parseOptionalTime(promo.ValidFrom)
+	if !validFrom.IsZero() && now.Before(validFrom) {
+		return status.Errorf(codes.FailedPrecondition, "Promo code %s is not valid until %s", promo.Code, promo.ValidFrom)
+	}
+	validUntil, _ := parseOptionalTime(promo.ValidUntil)
+	if !validUntil.IsZero() && !now.Before(validUntil) {
+		return status.Errorf(codes.FailedPrecondition, "Promo code %s expired at %s", promo.Code, promo.ValidUntil)
+	}
+
+	if promo.MaxUses > 0 && promo.Uses >= promo.MaxUses {
+		return status.Errorf(codes.FailedPrecondition, "Promo code %s has been used up", promo.Code)
+	}
+	if email != "" && promo.MaxUsesPerEmail > 0 && promo.UsesByEmail[email] >= promo.MaxUsesPerEmail {
+		return status.Errorf(codes.FailedPrecondition, "Promo code %s can only be used %d time(s) per customer", promo.Code, promo.MaxUsesPerEmail)
+	}
+	return nil
+}
+
+// Helper function to describe the discount of a promo code
+func promoDescription(promo *pb.PromoCode) string {
+	switch {
+	case promo.Description != "":
+		return promo.Description
+	case promo.PercentOff > 0:
+		return fmt.Sprintf("%d%% off", promo.PercentOff)
+	default:
+		return fmt.Sprintf("%.2f %s off", MajorUnits(promo.AmountOff), promo.AmountOff.GetCurrency())
+	}
+}
+
+// Helper function to work out the discount a promo code gives on a fare.
+// The discount never takes the fare below zero.
+func promoDiscount(promo *pb.PromoCode, fare *pb.Money) *pb.Discount {
+	return promoDiscounts(promo, fare, 1)[0]
+}
+
+// Helper function to work out the discount a promo code gives on each of
+// count tickets at fare. A percentage comes off every ticket, while a fixed
+// amount comes off the booking once, spread across its tickets. No ticket
+// is taken below zero.
+func promoDiscounts(promo *pb.PromoCode, fare *pb.Money, count int) []*pb.Discount {
+	amounts := make([]int64, count)
+	if promo.PercentOff > 0 {
+		for i := range amounts {
+			amounts[i] = percentOf(fare.AmountMinor, int(promo.PercentOff))
+			if amounts[i] > fare.AmountMinor {
+				amounts[i] = fare.AmountMinor
+			}
+		}
+	} else {
+		amount := promo.AmountOff.GetAmountMinor()
+		if total := fare.AmountMinor * int64(count); amount > total {
+			amount = total
+		}
+		// The first tickets take the minor units that do not share out evenly
+		for i := range amounts {
+			amounts[i] = amount / int64(count)
+			if int64(i) < amount%int64(count) {
+				amounts[i]++
+			}
+		}
+	}
+
+	discounts := make([]*pb.Discount, count)
+	for i, amount := range amounts {
+		discounts[i] = &pb.Discount{
+			Code:        promo.Code,
+			Description: promoDescription(promo),
+			Amount:      &pb.Money{AmountMinor: amount, Currency: fare.Currency},
+		}
+	}
+	return discounts
+}
+
+// Helper function to look up a promo code and check it can be used at now
+// by email. Callers must hold s.mu.
+func (s *Server) usablePromo(code, email string, now time.Time) (*pb.PromoCode, error) {
+	promo, ok := s.promos.GetPromo(normalizePromoCode(code))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Unknown promo code: %s", code)
+	}
+	if err := checkPromo(promo, email, now); err != nil {
+		return nil, err
+	}
+	return promo, nil
+}
+
+// Helper function to count one use of a promo code by email.
+// Callers must hold s.mu for writing.
+func (s *Server) recordPromoUse(promo *pb.PromoCode, email string) error {
+	promo.Uses++
+	if promo.UsesByEmail == nil {
+		promo.UsesByEmail = make(map[string]int32)
+	}
+	promo.UsesByEmail[email]++
+	return s.promos.PutPromo(promo)
+}
+
+// Helper function to give back a use recorded by recordPromoUse when the
+// purchase it was for could not be saved or paid for. The code is read
+// again, so uses recorded by other purchases since are kept. Callers must
+// hold s.mu for writing.
+func (s *Server) releasePromoUse(code, email string) {
+	promo, ok := s.promos.GetPromo(code)
+	if !ok {
+		return
+	}
+	promo.Uses--
+	promo.UsesByEmail[email]--
+	if promo.UsesByEmail[email] <= 0 {
+		delete(promo.UsesByEmail, email)
+	}
+	if err := s.promos.PutPromo(promo); err != nil {
+		log.Printf("promo store: releasing use of %s: %v", promo.Code, err)
+	}
+}
+
+func (s *AdminServer) CreatePromoCode(ctx context.Context, req *pb.CreatePromoCodeRequest) (*pb.CreatePromoCodeResponse, error) {
+	// Validate the request
+	if req == nil || req.Promo == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Promo code cannot be empty")
+	}
+
+	promo := &pb.PromoCode{
+		Code:            normalizePromoCode(req.Promo.Code),
+		Description:     req.Promo.Description,
+		PercentOff:      req.Promo.PercentOff,
+		AmountOff:       req.Promo.AmountOff,
+		ValidFrom:       req.Promo.ValidFrom,
+		ValidUntil:      req.Promo.ValidUntil,
+		MaxUses:         req.Promo.MaxUses,
+		MaxUsesPerEmail: req.Promo.MaxUsesPerEmail,
+	}
+
+	if !promoCodePattern.MatchString(promo.Code) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid promo code %q: use 3-32 letters, digits, '-' or '_'", req.Promo.Code)
+	}
+
+	switch {
+	case promo.PercentOff != 0 && promo.AmountOff != nil:
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid promo code: Set either percent_off or amount_off, not both")
+	case promo.PercentOff != 0:
+		if promo.PercentOff < 1 || promo.PercentOff > 100 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid promo code: percent_off must be between 1 and 100")
+		}
+	case promo.AmountOff != nil:
+		if promo.AmountOff.AmountMinor < 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid promo code: amount_off must be positive")
+		}
+		currency := strings.ToUpper(promo.AmountOff.Currency)
+		if currency == "" {
+			currency = s.catalog.Pricing.Currency
+		}
+		if currency != s.catalog.Pricing.Currency {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid promo code: amount_off must be in %s", s.catalog.Pricing.Currency)
+		}
+		promo.AmountOff = &pb.Money{AmountMinor: promo.AmountOff.AmountMinor, Currency: currency}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid promo code: Set percent_off or amount_off")
+	}
+
+	validFrom, err := parseOptionalTime(promo.ValidFrom)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid valid_from: %s (expected RFC 3339)", promo.ValidFrom)
+	}
+	validUntil, err := parseOptionalTime(promo.ValidUntil)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid valid_until: %s (expected RFC 3339)", promo.ValidUntil)
+	}
+	if !validFrom.IsZero() && !validUntil.IsZero() && !validUntil.After(validFrom) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid promo code: valid_until must be after valid_from")
+	}
+
+	if promo.MaxUses < 0 || promo.MaxUsesPerEmail < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid promo code: Usage limits cannot be negative")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.promos.GetPromo(promo.Code); exists {
+		return nil, status.Errorf(codes.AlreadyExists, "Promo code %s already exists", promo.Code)
+	}
+
+	if err := s.promos.PutPromo(promo); err != nil {
+		return nil, storeError(err)
+	}
+
+	return &pb.CreatePromoCodeResponse{Promo: promo}, nil
+}
+
+func (s *AdminServer) DisablePromoCode(ctx context.Context, req *pb.DisablePromoCodeRequest) (*pb.DisablePromoCodeResponse, error) {
+	// Validate the request
+	if req == nil || req.Code == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Promo code cannot be empty")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	promo, exists := s.promos.GetPromo(normalizePromoCode(req.Code))
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "Unknown promo code: %s", req.Code)
+	}
+
+	if !promo.Disabled {
+		promo.Disabled = true
+		if err := s.promos.PutPromo(promo); err != nil {
+			return nil, storeError(err)
+		}
+	}
+
+	return &pb.DisablePromoCodeResponse{Promo: promo}, nil
+}
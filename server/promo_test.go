@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+)
+
+// Helper function to buy a group booking of count passengers with a promo
+// code giving amountOff minor units off
+func purchaseGroupWithAmountOff(t *testing.T, count int, amountOff int64) (*pb.PurchaseResponse, *pb.QuoteFareResponse) {
+	t.Helper()
+	s := newTestServer(t)
+	admin := &AdminServer{Server: s}
+	_, err := admin.CreatePromoCode(adminContext(), &pb.CreatePromoCodeRequest{
+		Promo: &pb.PromoCode{Code: "OFF", AmountOff: &pb.Money{AmountMinor: amountOff}},
+	})
+	if err != nil {
+		t.Fatalf("CreatePromoCode: %v", err)
+	}
+
+	quote, err := s.QuoteFare(context.Background(), &pb.QuoteFareRequest{From: "London", To: "Paris"})
+	if err != nil {
+		t.Fatalf("QuoteFare: %v", err)
+	}
+
+	var passengers []*pb.User
+	for i := 0; i < count; i++ {
+		passengers = append(passengers, &pb.User{FirstName: "Group", LastName: "Passenger"})
+	}
+	response, err := s.PurchaseTicket(context.Background(), &pb.PurchaseRequest{
+		From: "London", To: "Paris", PromoCode: "OFF", Passengers: passengers,
+		User: &pb.User{FirstName: "Group", LastName: "Lead", Email: "group@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket: %v", err)
+	}
+	return response, quote
+}
+
+func TestAmountOffIsTakenOnceFromGroupBooking(t *testing.T) {
+	response, quote := purchaseGroupWithAmountOff(t, 3, 1000)
+	fare := quote.Total.AmountMinor
+
+	if got := response.Discount.GetAmount().GetAmountMinor(); got != 1000 {
+		t.Fatalf("booking discount is %d, want 1000", got)
+	}
+	if got, want := response.Price.GetAmountMinor(), 3*fare-1000; got != want {
+		t.Fatalf("booking price is %d, want %d", got, want)
+	}
+
+	// The discount is shared out, the first ticket taking the odd minor unit
+	var sum int64
+	for i, want := range []int64{334, 333, 333} {
+		ticket := response.Tickets[i]
+		if got := ticket.Discount.GetAmount().GetAmountMinor(); got != want {
+			t.Fatalf("ticket %d discount is %d, want %d", i, got, want)
+		}
+		if got := ticket.Price.GetAmountMinor(); got != fare-want {
+			t.Fatalf("ticket %d price is %d, want %d", i, got, fare-want)
+		}
+		sum += ticket.Price.GetAmountMinor()
+	}
+	if sum != response.Price.GetAmountMinor() {
+		t.Fatalf("tickets add up to %d, but the booking price is %d", sum, response.Price.GetAmountMinor())
+	}
+}
+
+func TestAmountOffIsCappedAtGroupBookingPrice(t *testing.T) {
+	response, quote := purchaseGroupWithAmountOff(t, 2, 1000000)
+
+	if got, want := response.Discount.GetAmount().GetAmountMinor(), 2*quote.Total.AmountMinor; got != want {
+		t.Fatalf("booking discount is %d, want the whole price %d", got, want)
+	}
+	for i, ticket := range response.Tickets {
+		if got := ticket.Price.GetAmountMinor(); got != 0 {
+			t.Fatalf("ticket %d price is %d, want 0", i, got)
+		}
+	}
+}
+
+func TestGroupFareBreakdownAddsUpToBookingPrice(t *testing.T) {
+	response, quote := purchaseGroupWithAmountOff(t, 3, 1000)
+
+	var sum int64
+	for _, line := range response.FareBreakdown {
+		sum += line.Amount.GetAmountMinor()
+	}
+	if sum != response.Price.GetAmountMinor() {
+		t.Fatalf("fare breakdown adds up to %d, but the booking price is %d", sum, response.Price.GetAmountMinor())
+	}
+	// Each line covers every ticket, so there are no more lines than for one
+	if got, want := len(response.FareBreakdown), len(quote.Lines)+1; got != want {
+		t.Fatalf("fare breakdown has %d lines, want %d", got, want)
+	}
+}
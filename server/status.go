@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// How long before boarding check-in opens
+const checkInOpens = 24 * time.Hour
+
+// How long before boarding passengers may board
+const boardingOpens = 30 * time.Minute
+
+// ticketTransitions lists the statuses a ticket can move to from each
+// status. A seated ticket can move to another seat and stay seated. A
+// ticket awaiting payment is withdrawn rather than cancelled if the payment
+// fails.
+var ticketTransitions = map[pb.TicketStatus][]pb.TicketStatus{
+	pb.TicketStatus_TICKET_STATUS_PENDING_PAYMENT: {pb.TicketStatus_TICKET_STATUS_PURCHASED, pb.TicketStatus_TICKET_STATUS_EXPIRED},
+	pb.TicketStatus_TICKET_STATUS_PURCHASED:       {pb.TicketStatus_TICKET_STATUS_SEATED, pb.TicketStatus_TICKET_STATUS_CANCELLED, pb.TicketStatus_TICKET_STATUS_EXPIRED},
+	pb.TicketStatus_TICKET_STATUS_SEATED:          {pb.TicketStatus_TICKET_STATUS_SEATED, pb.TicketStatus_TICKET_STATUS_CHECKED_IN, pb.TicketStatus_TICKET_STATUS_CANCELLED, pb.TicketStatus_TICKET_STATUS_EXPIRED},
+	pb.TicketStatus_TICKET_STATUS_CHECKED_IN:      {pb.TicketStatus_TICKET_STATUS_BOARDED, pb.TicketStatus_TICKET_STATUS_EXPIRED},
+	pb.TicketStatus_TICKET_STATUS_CANCELLED:       {pb.TicketStatus_TICKET_STATUS_REFUNDED},
+}
+
+// How each status reads in error messages
+var statusNames = map[pb.TicketStatus]string{
+	pb.TicketStatus_TICKET_STATUS_PENDING_PAYMENT: "awaiting payment",
+	pb.TicketStatus_TICKET_STATUS_PURCHASED:       "purchased",
+	pb.TicketStatus_TICKET_STATUS_SEATED:          "seated",
+	pb.TicketStatus_TICKET_STATUS_CHECKED_IN:      "checked in",
+	pb.TicketStatus_TICKET_STATUS_BOARDED:         "boarded",
+	pb.TicketStatus_TICKET_STATUS_CANCELLED:       "cancelled",
+	pb.TicketStatus_TICKET_STATUS_REFUNDED:        "cancelled and refunded",
+	pb.TicketStatus_TICKET_STATUS_EXPIRED:         "expired",
+}
+
+// Helper function to work out the status of a receipt stored before
+// statuses were recorded
+func legacyStatus(receipt *pb.Receipt) pb.TicketStatus {
+	switch {
+	case receipt.Cancellation != nil:
+		return pb.TicketStatus_TICKET_STATUS_CANCELLED
+	case receipt.GetSeat().GetSection() != "" && receipt.GetSeat().GetSeatNumber() > 0:
+		return pb.TicketStatus_TICKET_STATUS_SEATED
+	default:
+		return pb.TicketStatus_TICKET_STATUS_PURCHASED
+	}
+}
+
+// refreshStatus brings the status of a receipt read from the store up to
+// date: receipts from before statuses were recorded get one, and tickets
+// still unused when their train leaves read as expired. The change is
+// stored the next time the receipt is.
+func (s *Server) refreshStatus(receipt *pb.Receipt) {
+	if receipt.Status == pb.TicketStatus_TICKET_STATUS_UNSPECIFIED {
+		receipt.Status = legacyStatus(receipt)
+	}
+	if !canTransition(receipt.Status, pb.TicketStatus_TICKET_STATUS_EXPIRED) {
+		return
+	}
+	departure, err := time.Parse(time.RFC3339, receipt.DepartureTime)
+	if err == nil && !s.now().Before(departure) {
+		receipt.Status = pb.TicketStatus_TICKET_STATUS_EXPIRED
+	}
+}
+
+// Helper function to refresh the status of every receipt in a list
+func (s *Server) refreshStatuses(receipts []*pb.Receipt) []*pb.Receipt {
+	for _, receipt := range receipts {
+		s.refreshStatus(receipt)
+	}
+	return receipts
+}
+
+// Helper function to report whether a ticket may move between two statuses
+func canTransition(from, to pb.TicketStatus) bool {
+	for _, next := range ticketTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Helper function to report whether a ticket can still be used or changed
+func isActive(status pb.TicketStatus) bool {
+	return len(ticketTransitions[status]) > 0 && status != pb.TicketStatus_TICKET_STATUS_CANCELLED
+}
+
+// Helper function to check that a ticket may move to a status, describing
+// the action attempted if not
+func checkTransition(receipt *pb.Receipt, to pb.TicketStatus, action string) error {
+	if canTransition(receipt.Status, to) {
+		return nil
+	}
+	return status.Errorf(codes.FailedPrecondition, "Cannot %s: purchase %s is %s", action, receipt.PurchaseId, statusNames[receipt.Status])
+}
+
+// Helper function to check that the current time falls in a window before
+// a ticket's boarding time
+func (s *Server) checkWindow(receipt *pb.Receipt, opens time.Duration, action string) error {
+	departure, err := time.Parse(time.RFC3339, receipt.DepartureTime)
+	if err != nil {
+		return nil
+	}
+	if now := s.now(); now.Before(departure.Add(-opens)) {
+		return status.Errorf(codes.FailedPrecondition, "Cannot %s yet: opens at %s", action, departure.Add(-opens).Format(time.RFC3339))
+	}
+	return nil
+}
+
+func (s *Server) CheckIn(ctx context.Context, req *pb.CheckInRequest) (*pb.CheckInResponse, error) {
+	// Validate the request
+	if req == nil || req.PurchaseId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Purchase id cannot be empty")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	receipt, err := s.lookupOwnTicket(ctx, req.PurchaseId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if receipt.Status == pb.TicketStatus_TICKET_STATUS_PURCHASED {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot check in: purchase %s has no seat allocated", receipt.PurchaseId)
+	}
+	if err := checkTransition(receipt, pb.TicketStatus_TICKET_STATUS_CHECKED_IN, "check in"); err != nil {
+		return nil, err
+	}
+	if err := s.checkWindow(receipt, checkInOpens, "check in"); err != nil {
+		return nil, err
+	}
+
+	receipt.Status = pb.TicketStatus_TICKET_STATUS_CHECKED_IN
+	receipt.CheckedInAt = s.now().Format(time.RFC3339)
+	if err := s.store.PutReceipt(receipt); err != nil {
+		return nil, storeError(err)
+	}
+
+	return &pb.CheckInResponse{Receipt: receipt}, nil
+}
+
+func (s *Server) Board(ctx context.Context, req *pb.BoardRequest) (*pb.BoardResponse, error) {
+	// Validate the request
+	if req == nil || req.PurchaseId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Purchase id cannot be empty")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	receipt, err := s.lookupOwnTicket(ctx, req.PurchaseId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if receipt.Status == pb.TicketStatus_TICKET_STATUS_SEATED {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot board: purchase %s has not checked in", receipt.PurchaseId)
+	}
+	if err := checkTransition(receipt, pb.TicketStatus_TICKET_STATUS_BOARDED, "board"); err != nil {
+		return nil, err
+	}
+	if err := s.checkWindow(receipt, boardingOpens, "board"); err != nil {
+		return nil, err
+	}
+
+	receipt.Status = pb.TicketStatus_TICKET_STATUS_BOARDED
+	receipt.BoardedAt = s.now().Format(time.RFC3339)
+	if err := s.store.PutReceipt(receipt); err != nil {
+		return nil, storeError(err)
+	}
+
+	return &pb.BoardResponse{Receipt: receipt}, nil
+}
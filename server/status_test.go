@@ -0,0 +1,159 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Helper function to buy a ticket from London to Paris on the 09:00 train
+// of Monday 10 June 2024
+func purchaseForMonday(t *testing.T, s *Server, email string) string {
+	t.Helper()
+	response, err := s.PurchaseTicket(adminContext(), &pb.PurchaseRequest{
+		From: "London",
+		To:   "Paris",
+		Date: "2024-06-10",
+		User: &pb.User{FirstName: "Test", LastName: "Passenger", Email: email},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket: %v", err)
+	}
+	return response.PurchaseId
+}
+
+// Helper function to read a ticket's current status
+func ticketStatus(t *testing.T, s *Server, purchaseID string) pb.TicketStatus {
+	t.Helper()
+	receipt, err := s.ShowReceipt(adminContext(), &pb.ShowReceiptRequest{PurchaseId: purchaseID})
+	if err != nil {
+		t.Fatalf("ShowReceipt(%s): %v", purchaseID, err)
+	}
+	return receipt.GetUserInfo().GetStatus()
+}
+
+func TestTicketTransitions(t *testing.T) {
+	tests := []struct {
+		from, to pb.TicketStatus
+		want     bool
+	}{
+		{pb.TicketStatus_TICKET_STATUS_PENDING_PAYMENT, pb.TicketStatus_TICKET_STATUS_PURCHASED, true},
+		{pb.TicketStatus_TICKET_STATUS_PENDING_PAYMENT, pb.TicketStatus_TICKET_STATUS_SEATED, false},
+		{pb.TicketStatus_TICKET_STATUS_PURCHASED, pb.TicketStatus_TICKET_STATUS_SEATED, true},
+		{pb.TicketStatus_TICKET_STATUS_PURCHASED, pb.TicketStatus_TICKET_STATUS_CHECKED_IN, false},
+		{pb.TicketStatus_TICKET_STATUS_SEATED, pb.TicketStatus_TICKET_STATUS_SEATED, true},
+		{pb.TicketStatus_TICKET_STATUS_SEATED, pb.TicketStatus_TICKET_STATUS_CHECKED_IN, true},
+		{pb.TicketStatus_TICKET_STATUS_SEATED, pb.TicketStatus_TICKET_STATUS_BOARDED, false},
+		{pb.TicketStatus_TICKET_STATUS_CHECKED_IN, pb.TicketStatus_TICKET_STATUS_BOARDED, true},
+		{pb.TicketStatus_TICKET_STATUS_CHECKED_IN, pb.TicketStatus_TICKET_STATUS_CANCELLED, false},
+		{pb.TicketStatus_TICKET_STATUS_CHECKED_IN, pb.TicketStatus_TICKET_STATUS_SEATED, false},
+		{pb.TicketStatus_TICKET_STATUS_CANCELLED, pb.TicketStatus_TICKET_STATUS_REFUNDED, true},
+		{pb.TicketStatus_TICKET_STATUS_CANCELLED, pb.TicketStatus_TICKET_STATUS_SEATED, false},
+		{pb.TicketStatus_TICKET_STATUS_BOARDED, pb.TicketStatus_TICKET_STATUS_EXPIRED, false},
+		{pb.TicketStatus_TICKET_STATUS_REFUNDED, pb.TicketStatus_TICKET_STATUS_CANCELLED, false},
+		{pb.TicketStatus_TICKET_STATUS_EXPIRED, pb.TicketStatus_TICKET_STATUS_SEATED, false},
+	}
+	for _, tt := range tests {
+		if got := canTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("canTransition(%v, %v): got %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestCheckInAndBoard(t *testing.T) {
+	s := newTestServer(t)
+	clock := &fakeClock{now: time.Date(2024, 6, 7, 10, 0, 0, 0, time.Local)}
+	s.now = clock.Now
+	ctx := adminContext()
+	purchaseID := purchaseForMonday(t, s, "passenger@example.com")
+
+	checkIn := func() error {
+		_, err := s.CheckIn(ctx, &pb.CheckInRequest{PurchaseId: purchaseID})
+		return err
+	}
+	board := func() error {
+		_, err := s.Board(ctx, &pb.BoardRequest{PurchaseId: purchaseID})
+		return err
+	}
+	allocate := func() error {
+		_, err := s.AllocateSeat(ctx, &pb.AllocateSeatRequest{PurchaseId: purchaseID, Section: "A"})
+		return err
+	}
+	cancel := func() error {
+		_, err := s.CancelTicket(ctx, &pb.CancelTicketRequest{PurchaseId: purchaseID})
+		return err
+	}
+
+	tests := []struct {
+		name       string
+		at         time.Time
+		action     func() error
+		wantCode   codes.Code
+		wantStatus pb.TicketStatus
+	}{
+		{"check in without a seat", clock.now, checkIn, codes.FailedPrecondition, pb.TicketStatus_TICKET_STATUS_PURCHASED},
+		{"allocate a seat", clock.now, allocate, codes.OK, pb.TicketStatus_TICKET_STATUS_SEATED},
+		{"board before checking in", clock.now, board, codes.FailedPrecondition, pb.TicketStatus_TICKET_STATUS_SEATED},
+		{"check in too early", time.Date(2024, 6, 9, 8, 59, 0, 0, time.Local), checkIn, codes.FailedPrecondition, pb.TicketStatus_TICKET_STATUS_SEATED},
+		{"check in", time.Date(2024, 6, 9, 9, 0, 0, 0, time.Local), checkIn, codes.OK, pb.TicketStatus_TICKET_STATUS_CHECKED_IN},
+		{"check in twice", time.Date(2024, 6, 9, 9, 0, 0, 0, time.Local), checkIn, codes.FailedPrecondition, pb.TicketStatus_TICKET_STATUS_CHECKED_IN},
+		{"cancel after checking in", time.Date(2024, 6, 9, 9, 0, 0, 0, time.Local), cancel, codes.FailedPrecondition, pb.TicketStatus_TICKET_STATUS_CHECKED_IN},
+		{"board too early", time.Date(2024, 6, 10, 8, 29, 0, 0, time.Local), board, codes.FailedPrecondition, pb.TicketStatus_TICKET_STATUS_CHECKED_IN},
+		{"board", time.Date(2024, 6, 10, 8, 30, 0, 0, time.Local), board, codes.OK, pb.TicketStatus_TICKET_STATUS_BOARDED},
+		{"board twice", time.Date(2024, 6, 10, 8, 30, 0, 0, time.Local), board, codes.FailedPrecondition, pb.TicketStatus_TICKET_STATUS_BOARDED},
+		{"after departure", time.Date(2024, 6, 10, 12, 0, 0, 0, time.Local), board, codes.FailedPrecondition, pb.TicketStatus_TICKET_STATUS_BOARDED},
+	}
+	for _, tt := range tests {
+		clock.now = tt.at
+		if err := tt.action(); status.Code(err) != tt.wantCode {
+			t.Fatalf("%s: got %v, want %v", tt.name, err, tt.wantCode)
+		}
+		if got := ticketStatus(t, s, purchaseID); got != tt.wantStatus {
+			t.Fatalf("%s: ticket is %v, want %v", tt.name, got, tt.wantStatus)
+		}
+	}
+}
+
+func TestUnusedTicketsExpireAtDeparture(t *testing.T) {
+	tests := []struct {
+		name           string
+		checkIn, board bool
+		wantStatus     pb.TicketStatus
+	}{
+		{"seated", false, false, pb.TicketStatus_TICKET_STATUS_EXPIRED},
+		{"checked in", true, false, pb.TicketStatus_TICKET_STATUS_EXPIRED},
+		{"boarded", true, true, pb.TicketStatus_TICKET_STATUS_BOARDED},
+	}
+	for _, tt := range tests {
+		s := newTestServer(t)
+		clock := &fakeClock{now: time.Date(2024, 6, 10, 8, 45, 0, 0, time.Local)}
+		s.now = clock.Now
+		ctx := adminContext()
+		purchaseID := purchaseForMonday(t, s, "passenger@example.com")
+		if _, err := s.AllocateSeat(ctx, &pb.AllocateSeatRequest{PurchaseId: purchaseID, Section: "A"}); err != nil {
+			t.Fatalf("%s: AllocateSeat: %v", tt.name, err)
+		}
+		if tt.checkIn {
+			if _, err := s.CheckIn(ctx, &pb.CheckInRequest{PurchaseId: purchaseID}); err != nil {
+				t.Fatalf("%s: CheckIn: %v", tt.name, err)
+			}
+		}
+		if tt.board {
+			if _, err := s.Board(ctx, &pb.BoardRequest{PurchaseId: purchaseID}); err != nil {
+				t.Fatalf("%s: Board: %v", tt.name, err)
+			}
+		}
+
+		clock.now = time.Date(2024, 6, 10, 9, 0, 0, 0, time.Local)
+		if got := ticketStatus(t, s, purchaseID); got != tt.wantStatus {
+			t.Errorf("%s: at departure the ticket is %v, want %v", tt.name, got, tt.wantStatus)
+		}
+		_, err := s.Board(ctx, &pb.BoardRequest{PurchaseId: purchaseID})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("%s: Board after departure: got %v, want FailedPrecondition", tt.name, err)
+		}
+	}
+}
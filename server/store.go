@@ -0,0 +1,439 @@
+package main
+
+import (
+	"sort"
+	"sync"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// BookingStore keeps every purchased ticket and answers which passenger holds
+// a given seat. Receipts are keyed by purchase ID and copied on the way in and
+// out, so callers are free to modify what they get back and must Put it again
+// to persist the change.
+type BookingStore interface {
+	// GetReceipt returns the receipt with the given purchase ID.
+	GetReceipt(purchaseID string) (*pb.Receipt, bool)
+	// PutReceipt inserts or replaces the receipt keyed by its purchase ID.
+	PutReceipt(receipt *pb.Receipt) error
+	// DeleteReceipt removes the receipt with the given purchase ID.
+	DeleteReceipt(purchaseID string) error
+	// ListByEmail returns every receipt whose passenger has the given email,
+	// in purchase order.
+	ListByEmail(email string) []*pb.Receipt
+	// ListBookedBy returns every receipt booked by the customer with the
+	// given email, in purchase order.
+	ListBookedBy(email string) []*pb.Receipt
+	// ListSwapRequests returns every receipt whose passenger has asked to
+	// swap seats with the given purchase, in purchase order.
+	ListSwapRequests(purchaseID string) []*pb.Receipt
+	// ListByBooking returns the receipts of every passenger of a booking, in
+	// the order the passengers were listed at purchase.
+	ListByBooking(bookingID string) []*pb.Receipt
+	// SeatOccupants returns every receipt holding a seat on a departure.
+	// Passengers travelling on legs of the route that do not overlap can
+	// share a seat, so there may be more than one.
+	SeatOccupants(departure Departure, section string, seatNumber int32) []*pb.Receipt
+	// ListBySection returns every receipt seated in a section, ordered by
+	// departure and seat. Empty departure fields match any train or date.
+	ListBySection(departure Departure, section string) []*pb.Receipt
+	// ListWaitlisted returns every receipt on the waitlist of a departure, in
+	// purchase order.
+	ListWaitlisted(departure Departure) []*pb.Receipt
+	// ListByDeparture returns every receipt for a departure, in purchase
+	// order.
+	ListByDeparture(departure Departure) []*pb.Receipt
+	// Ping reports why the store cannot save changes, or nil if it can.
+	Ping() error
+	// Close releases any resources held by the store.
+	Close() error
+}
+
+// PromoStore keeps the promo codes that can be applied at purchase time,
+// together with how often each has been used. Codes are copied on the way in
+// and out, like receipts in a BookingStore.
+type PromoStore interface {
+	// GetPromo returns the promo code with the given (normalized) code.
+	GetPromo(code string) (*pb.PromoCode, bool)
+	// PutPromo inserts or replaces a promo code.
+	PutPromo(promo *pb.PromoCode) error
+}
+
+// SeatBlockStore keeps the seats taken out of service. Blocks are keyed by
+// train, date, section and seat number, and copied on the way in and out.
+type SeatBlockStore interface {
+	// SeatBlocked reports whether a seat is blocked on a departure, either
+	// on its date or on every date.
+	SeatBlocked(departure Departure, section string, seatNumber int32) bool
+	// ListSeatBlocks returns the blocks on a train, or on every train if
+	// trainID is empty, ordered by train, date, section and seat.
+	ListSeatBlocks(trainID string) []*pb.SeatBlock
+	// PutSeatBlock inserts or replaces a block.
+	PutSeatBlock(block *pb.SeatBlock) error
+	// DeleteSeatBlock removes the block with the same key as block, if any.
+	DeleteSeatBlock(block *pb.SeatBlock) error
+}
+
+// storage is implemented by every storage backend.
+type storage interface {
+	BookingStore
+	PromoStore
+	SeatBlockStore
+}
+
+type seatKey struct {
+	departure  Departure
+	section    string
+	seatNumber int32
+}
+
+// Helper function to build the key of a seat block
+func blockKey(block *pb.SeatBlock) seatKey {
+	return seatKey{
+		departure:  Departure{TrainID: block.TrainId, Date: block.Date},
+		section:    block.Section,
+		seatNumber: block.SeatNumber,
+	}
+}
+
+// memoryStore is a BookingStore, PromoStore and SeatBlockStore that lives
+// only as long as the process.
+type memoryStore struct {
+	mu       sync.RWMutex
+	receipts map[string]*pb.Receipt
+	order    map[string]int
+	next     int
+	emails   map[string][]string
+	bookers  map[string][]string
+	bookings map[string][]string
+	swaps    map[string][]string
+	seats    map[seatKey][]string
+	promos   map[string]*pb.PromoCode
+	blocks   map[seatKey]*pb.SeatBlock
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		receipts: make(map[string]*pb.Receipt),
+		order:    make(map[string]int),
+		emails:   make(map[string][]string),
+		bookers:  make(map[string][]string),
+		bookings: make(map[string][]string),
+		swaps:    make(map[string][]string),
+		seats:    make(map[seatKey][]string),
+		promos:   make(map[string]*pb.PromoCode),
+		blocks:   make(map[seatKey]*pb.SeatBlock),
+	}
+}
+
+// Helper function to remove one purchase ID from an index entry
+func removeFromIndex(index map[string][]string, key, purchaseID string) {
+	var kept []string
+	for _, id := range index[key] {
+		if id != purchaseID {
+			kept = append(kept, id)
+		}
+	}
+	if len(kept) == 0 {
+		delete(index, key)
+	} else {
+		index[key] = kept
+	}
+}
+
+// Helper function to build the seat index key for a seated receipt
+func receiptSeat(receipt *pb.Receipt) (seatKey, bool) {
+	if receipt.GetSeat().GetSection() == "" || receipt.GetSeat().GetSeatNumber() < 1 {
+		return seatKey{}, false
+	}
+	return seatKey{
+		departure:  receiptDeparture(receipt),
+		section:    receipt.Seat.Section,
+		seatNumber: receipt.Seat.SeatNumber,
+	}, true
+}
+
+// Helper function to identify the departure a receipt was purchased for
+func receiptDeparture(receipt *pb.Receipt) Departure {
+	return Departure{TrainID: receipt.GetTrainId(), Date: receipt.GetDate()}
+}
+
+func (m *memoryStore) GetReceipt(purchaseID string) (*pb.Receipt, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	receipt, ok := m.receipts[purchaseID]
+	if !ok {
+		return nil, false
+	}
+	return proto.Clone(receipt).(*pb.Receipt), true
+}
+
+func (m *memoryStore) PutReceipt(receipt *pb.Receipt) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.put(proto.Clone(receipt).(*pb.Receipt))
+	return nil
+}
+
+// put stores receipt without copying it, keeping its position in purchase
+// order if it replaces an earlier version. Callers must hold m.mu.
+func (m *memoryStore) put(receipt *pb.Receipt) {
+	purchaseID := receipt.PurchaseId
+	order, exists := m.order[purchaseID]
+	if !exists {
+		order = m.next
+		m.next++
+	}
+	m.delete(purchaseID)
+	m.order[purchaseID] = order
+
+	m.receipts[purchaseID] = receipt
+	email := receipt.GetUser().GetEmail()
+	m.emails[email] = append(m.emails[email], purchaseID)
+	if receipt.BookedBy != "" {
+		m.bookers[receipt.BookedBy] = append(m.bookers[receipt.BookedBy], purchaseID)
+	}
+	if receipt.BookingId != "" {
+		m.bookings[receipt.BookingId] = append(m.bookings[receipt.BookingId], purchaseID)
+	}
+	if with := receipt.SwapRequest.GetWithPurchaseId(); with != "" {
+		m.swaps[with] = append(m.swaps[with], purchaseID)
+	}
+	if key, ok := receiptSeat(receipt); ok {
+		m.seats[key] = append(m.seats[key], purchaseID)
+	}
+}
+
+func (m *memoryStore) DeleteReceipt(purchaseID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.delete(purchaseID)
+	return nil
+}
+
+// delete removes a receipt and its index entries. Callers must hold m.mu.
+func (m *memoryStore) delete(purchaseID string) {
+	old, ok := m.receipts[purchaseID]
+	if !ok {
+		return
+	}
+	removeFromIndex(m.emails, old.GetUser().GetEmail(), purchaseID)
+	if old.BookedBy != "" {
+		removeFromIndex(m.bookers, old.BookedBy, purchaseID)
+	}
+	if old.BookingId != "" {
+		removeFromIndex(m.bookings, old.BookingId, purchaseID)
+	}
+	if with := old.SwapRequest.GetWithPurchaseId(); with != "" {
+		removeFromIndex(m.swaps, with, purchaseID)
+	}
+	if key, ok := receiptSeat(old); ok {
+		holders := m.seats[key][:0]
+		for _, holder := range m.seats[key] {
+			if holder != purchaseID {
+				holders = append(holders, holder)
+			}
+		}
+		if len(holders) == 0 {
+			delete(m.seats, key)
+		} else {
+			m.seats[key] = holders
+		}
+	}
+	delete(m.receipts, purchaseID)
+	delete(m.order, purchaseID)
+}
+
+// Helper function to copy the receipts listed in an index entry, in
+// purchase order. Callers must hold m.mu.
+func (m *memoryStore) listIndexed(purchaseIDs []string) []*pb.Receipt {
+	receipts := make([]*pb.Receipt, 0, len(purchaseIDs))
+	for _, purchaseID := range purchaseIDs {
+		receipts = append(receipts, proto.Clone(m.receipts[purchaseID]).(*pb.Receipt))
+	}
+	sort.Slice(receipts, func(i, j int) bool {
+		return m.order[receipts[i].PurchaseId] < m.order[receipts[j].PurchaseId]
+	})
+	return receipts
+}
+
+func (m *memoryStore) ListByEmail(email string) []*pb.Receipt {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.listIndexed(m.emails[email])
+}
+
+func (m *memoryStore) ListBookedBy(email string) []*pb.Receipt {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.listIndexed(m.bookers[email])
+}
+
+func (m *memoryStore) ListSwapRequests(purchaseID string) []*pb.Receipt {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.listIndexed(m.swaps[purchaseID])
+}
+
+func (m *memoryStore) ListByBooking(bookingID string) []*pb.Receipt {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.listIndexed(m.bookings[bookingID])
+}
+
+func (m *memoryStore) SeatOccupants(departure Departure, section string, seatNumber int32) []*pb.Receipt {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var occupants []*pb.Receipt
+	for _, purchaseID := range m.seats[seatKey{departure: departure, section: section, seatNumber: seatNumber}] {
+		occupants = append(occupants, proto.Clone(m.receipts[purchaseID]).(*pb.Receipt))
+	}
+	return occupants
+}
+
+func (m *memoryStore) ListBySection(departure Departure, section string) []*pb.Receipt {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	receipts := []*pb.Receipt{}
+	for _, receipt := range m.receipts {
+		if receipt.GetSeat().GetSection() != section {
+			continue
+		}
+		if departure.TrainID != "" && receipt.TrainId != departure.TrainID {
+			continue
+		}
+		if departure.Date != "" && receipt.Date != departure.Date {
+			continue
+		}
+		receipts = append(receipts, proto.Clone(receipt).(*pb.Receipt))
+	}
+	sort.Slice(receipts, func(i, j int) bool {
+		a, b := receipts[i], receipts[j]
+		if a.Date != b.Date {
+			return a.Date < b.Date
+		}
+		if a.TrainId != b.TrainId {
+			return a.TrainId < b.TrainId
+		}
+		return a.Seat.SeatNumber < b.Seat.SeatNumber
+	})
+	return receipts
+}
+
+func (m *memoryStore) ListWaitlisted(departure Departure) []*pb.Receipt {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var purchaseIDs []string
+	for purchaseID, receipt := range m.receipts {
+		if receipt.Waitlist != nil && receiptDeparture(receipt) == departure {
+			purchaseIDs = append(purchaseIDs, purchaseID)
+		}
+	}
+	return m.listIndexed(purchaseIDs)
+}
+
+func (m *memoryStore) ListByDeparture(departure Departure) []*pb.Receipt {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var purchaseIDs []string
+	for purchaseID, receipt := range m.receipts {
+		if receiptDeparture(receipt) == departure {
+			purchaseIDs = append(purchaseIDs, purchaseID)
+		}
+	}
+	return m.listIndexed(purchaseIDs)
+}
+
+func (m *memoryStore) GetPromo(code string) (*pb.PromoCode, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	promo, ok := m.promos[code]
+	if !ok {
+		return nil, false
+	}
+	return proto.Clone(promo).(*pb.PromoCode), true
+}
+
+func (m *memoryStore) PutPromo(promo *pb.PromoCode) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.promos[promo.Code] = proto.Clone(promo).(*pb.PromoCode)
+	return nil
+}
+
+func (m *memoryStore) SeatBlocked(departure Departure, section string, seatNumber int32) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	key := seatKey{departure: departure, section: section, seatNumber: seatNumber}
+	if _, ok := m.blocks[key]; ok {
+		return true
+	}
+	key.departure.Date = ""
+	_, ok := m.blocks[key]
+	return ok
+}
+
+func (m *memoryStore) ListSeatBlocks(trainID string) []*pb.SeatBlock {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	blocks := []*pb.SeatBlock{}
+	for _, block := range m.blocks {
+		if trainID == "" || block.TrainId == trainID {
+			blocks = append(blocks, proto.Clone(block).(*pb.SeatBlock))
+		}
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		a, b := blocks[i], blocks[j]
+		if a.TrainId != b.TrainId {
+			return a.TrainId < b.TrainId
+		}
+		if a.Date != b.Date {
+			return a.Date < b.Date
+		}
+		if a.Section != b.Section {
+			return a.Section < b.Section
+		}
+		return a.SeatNumber < b.SeatNumber
+	})
+	return blocks
+}
+
+func (m *memoryStore) PutSeatBlock(block *pb.SeatBlock) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.blocks[blockKey(block)] = proto.Clone(block).(*pb.SeatBlock)
+	return nil
+}
+
+func (m *memoryStore) DeleteSeatBlock(block *pb.SeatBlock) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.blocks, blockKey(block))
+	return nil
+}
+
+func (m *memoryStore) Ping() error {
+	return nil
+}
+
+func (m *memoryStore) Close() error {
+	return nil
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Actor recorded in the audit trail for changes made by an admin
+const adminActor = "admin"
+
+// Helper function to add an entry to the audit trail of a receipt
+func (s *Server) audit(receipt *pb.Receipt, action, actor, detail string) {
+	receipt.Audit = append(receipt.Audit, &pb.AuditEntry{
+		At:     s.now().Format(time.RFC3339),
+		Action: action,
+		Actor:  actor,
+		Detail: detail,
+	})
+}
+
+// Helper function to describe a seat as section-number
+func seatLabel(seat *pb.Seat) string {
+	return fmt.Sprintf("%s-%d", seat.GetSection(), seat.GetSeatNumber())
+}
+
+// Helper function to check that a ticket has a seat that can be swapped
+func checkSwappable(receipt *pb.Receipt) error {
+	if receipt.Status == pb.TicketStatus_TICKET_STATUS_PURCHASED {
+		return status.Errorf(codes.FailedPrecondition, "Cannot swap seats: purchase %s has no seat allocated", receipt.PurchaseId)
+	}
+	return checkTransition(receipt, pb.TicketStatus_TICKET_STATUS_SEATED, "swap seats")
+}
+
+// Helper function to drop the swap requests a change of seat makes stale:
+// those of the tickets whose seat changed, and those other passengers made
+// to swap with them, so nobody is swapped into a seat they did not agree to.
+// The other passengers' tickets are stored here; callers store the changed
+// tickets themselves. Callers must hold s.mu for writing.
+func (s *Server) dropSwapRequests(tickets ...*pb.Receipt) error {
+	changed := make(map[string]bool)
+	for _, ticket := range tickets {
+		ticket.SwapRequest = nil
+		changed[ticket.PurchaseId] = true
+	}
+
+	for _, ticket := range tickets {
+		for _, receipt := range s.store.ListSwapRequests(ticket.PurchaseId) {
+			if changed[receipt.PurchaseId] {
+				continue
+			}
+			receipt.SwapRequest = nil
+			s.audit(receipt, "swap_request_dropped", ticket.PurchaseId, fmt.Sprintf("Request to swap seats with purchase %s dropped because its seat changed", ticket.PurchaseId))
+			if err := s.store.PutReceipt(receipt); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) SwapSeats(ctx context.Context, req *pb.SwapSeatsRequest) (*pb.SwapSeatsResponse, error) {
+	// Validate the request
+	if req == nil || req.PurchaseIdA == "" || req.PurchaseIdB == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Both purchase ids must be given")
+	}
+	if req.PurchaseIdA == req.PurchaseIdB {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Cannot swap a seat with itself")
+	}
+	admin := s.isAdmin(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, err := s.lookupOwnTicket(ctx, req.PurchaseIdA, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSwappable(a); err != nil {
+		return nil, err
+	}
+
+	// Without an admin, the swap waits until the other passenger asks for
+	// it too. Until then nothing about their ticket is given away, not even
+	// whether it exists: the request is only recorded.
+	b, err := s.lookupTicket(req.PurchaseIdB, "")
+	if !admin && (err != nil || b.SwapRequest.GetWithPurchaseId() != a.PurchaseId) {
+		a.SwapRequest = &pb.SwapRequest{
+			WithPurchaseId: req.PurchaseIdB,
+			RequestedAt:    s.now().Format(time.RFC3339),
+		}
+		s.audit(a, "swap_requested", a.PurchaseId, fmt.Sprintf("Asked to swap seat %s with purchase %s", seatLabel(a.Seat), req.PurchaseIdB))
+		if err := s.store.PutReceipt(a); err != nil {
+			return nil, storeError(err)
+		}
+		return &pb.SwapSeatsResponse{Swapped: false, Receipt: a}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that both passengers are seated on the same departure
+	if err := checkSwappable(b); err != nil {
+		return nil, err
+	}
+	departure := receiptDeparture(a)
+	if receiptDeparture(b) != departure {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot swap seats: purchases %s and %s are for different trains", a.PurchaseId, b.PurchaseId)
+	}
+
+	train, err := s.receiptTrain(a)
+	if err != nil {
+		return nil, err
+	}
+	sectionA := train.Section(a.Seat.Section)
+	sectionB := train.Section(b.Seat.Section)
+	if sectionA == nil || sectionB == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot swap seats: train %s no longer has one of the sections", train.ID)
+	}
+
+	// Each passenger must have paid for the class of the other's seat
+	if err := checkSeatClass(a, sectionB); err != nil {
+		return nil, err
+	}
+	if err := checkSeatClass(b, sectionA); err != nil {
+		return nil, err
+	}
+
+	// Passengers on different legs can only swap if nobody else uses either
+	// seat on the part of the route the other travels
+	if !s.seatFreeWithout(train, departure, sectionB.Name, b.Seat.SeatNumber, receiptLeg(train, a), a.PurchaseId, b.PurchaseId) ||
+		!s.seatFreeWithout(train, departure, sectionA.Name, a.Seat.SeatNumber, receiptLeg(train, b), a.PurchaseId, b.PurchaseId) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cannot swap seats: another passenger uses one of the seats on the other journey")
+	}
+
+	actor := a.PurchaseId
+	if admin {
+		actor = adminActor
+	}
+
+	// Swap the seats of both tickets together
+	previous := []*pb.Receipt{proto.Clone(a).(*pb.Receipt), proto.Clone(b).(*pb.Receipt)}
+	a.Seat, b.Seat = b.Seat, a.Seat
+	if err := s.dropSwapRequests(a, b); err != nil {
+		return nil, storeError(err)
+	}
+	s.audit(a, "seat_swapped", actor, fmt.Sprintf("Seat %s swapped for %s with purchase %s", seatLabel(b.Seat), seatLabel(a.Seat), b.PurchaseId))
+	s.audit(b, "seat_swapped", actor, fmt.Sprintf("Seat %s swapped for %s with purchase %s", seatLabel(a.Seat), seatLabel(b.Seat), a.PurchaseId))
+	if err := s.putTickets([]*pb.Receipt{a, b}, previous); err != nil {
+		return nil, storeError(err)
+	}
+
+	log.Printf("swap: purchases %s and %s swapped seats %s and %s (by %s)", a.PurchaseId, b.PurchaseId, seatLabel(b.Seat), seatLabel(a.Seat), actor)
+	s.emitSeat(eventSeatSwapped, a)
+	s.emitSeat(eventSeatSwapped, b)
+
+	return &pb.SwapSeatsResponse{Swapped: true, Receipt: a}, nil
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Helper function to check that no audit entry of a receipt mentions text
+func checkAuditOmits(t *testing.T, receipt *pb.Receipt, text string) {
+	t.Helper()
+	for _, entry := range receipt.Audit {
+		if strings.Contains(entry.Detail, text) {
+			t.Fatalf("audit entry %q of purchase %s mentions %s", entry.Detail, receipt.PurchaseId, text)
+		}
+	}
+}
+
+func TestSwapRequestRevealsNothingBeforeConsent(t *testing.T) {
+	s := newTestServer(t)
+	first := purchaseTicket(t, s, "first@example.com", "London", "Paris")
+	if err := seatInA(t, s, first.PurchaseId, 3); err != nil {
+		t.Fatalf("seating first in A3: %v", err)
+	}
+
+	// The other ticket is for another leg and has no seat yet, which a
+	// request with its consent would be refused for
+	other := purchaseTicket(t, s, "other@example.com", "Paris", "Brussels")
+
+	// A ticket that exists and one that does not get the same answer
+	ctx := passengerContext("first@example.com")
+	for _, purchaseID := range []string{other.PurchaseId, "no-such-purchase"} {
+		response, err := s.SwapSeats(ctx, &pb.SwapSeatsRequest{PurchaseIdA: first.PurchaseId, PurchaseIdB: purchaseID})
+		if err != nil {
+			t.Fatalf("SwapSeats with %s: %v", purchaseID, err)
+		}
+		if response.Swapped || response.Receipt.PurchaseId != first.PurchaseId {
+			t.Fatalf("SwapSeats with %s: swapped %v, receipt %s, want only the request recorded", purchaseID, response.Swapped, response.Receipt.PurchaseId)
+		}
+		if response.Receipt.SwapRequest.GetWithPurchaseId() != purchaseID {
+			t.Fatalf("SwapSeats with %s recorded a request with %s", purchaseID, response.Receipt.SwapRequest.GetWithPurchaseId())
+		}
+	}
+
+	// An admin is told what is wrong
+	_, err := s.SwapSeats(adminContext(), &pb.SwapSeatsRequest{PurchaseIdA: first.PurchaseId, PurchaseIdB: "no-such-purchase"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("admin SwapSeats with an unknown ticket: got %v, want NotFound", err)
+	}
+	_, err = s.SwapSeats(adminContext(), &pb.SwapSeatsRequest{PurchaseIdA: first.PurchaseId, PurchaseIdB: other.PurchaseId})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("admin SwapSeats with an unseated ticket: got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestSwapAppliedOnceBothPassengersAgree(t *testing.T) {
+	s := newTestServer(t)
+	first := purchaseTicket(t, s, "first@example.com", "London", "Paris")
+	second := purchaseTicket(t, s, "second@example.com", "London", "Paris")
+	if err := seatInA(t, s, first.PurchaseId, 3); err != nil {
+		t.Fatalf("seating first in A3: %v", err)
+	}
+	if err := seatInA(t, s, second.PurchaseId, 4); err != nil {
+		t.Fatalf("seating second in A4: %v", err)
+	}
+
+	// The first request records the asker's own seat only
+	response, err := s.SwapSeats(passengerContext("first@example.com"), &pb.SwapSeatsRequest{PurchaseIdA: first.PurchaseId, PurchaseIdB: second.PurchaseId})
+	if err != nil {
+		t.Fatalf("SwapSeats asked by first: %v", err)
+	}
+	if response.Swapped {
+		t.Fatalf("seats swapped before the second passenger agreed")
+	}
+	checkAuditOmits(t, response.Receipt, "A-4")
+
+	// The second request makes the swap
+	response, err = s.SwapSeats(passengerContext("second@example.com"), &pb.SwapSeatsRequest{PurchaseIdA: second.PurchaseId, PurchaseIdB: first.PurchaseId})
+	if err != nil {
+		t.Fatalf("SwapSeats agreed by second: %v", err)
+	}
+	if !response.Swapped || response.Receipt.Seat.GetSeatNumber() != 3 {
+		t.Fatalf("second passenger: swapped %v, seat %s, want A-3", response.Swapped, seatLabel(response.Receipt.Seat))
+	}
+
+	receipt, err := s.lookupTicket(first.PurchaseId, "")
+	if err != nil {
+		t.Fatalf("lookupTicket: %v", err)
+	}
+	if receipt.Seat.GetSeatNumber() != 4 || receipt.SwapRequest != nil {
+		t.Fatalf("first passenger: seat %s, swap request %v, want A-4 and no request", seatLabel(receipt.Seat), receipt.SwapRequest)
+	}
+	last := receipt.Audit[len(receipt.Audit)-1]
+	if last.Action != "seat_swapped" || !strings.Contains(last.Detail, "A-4") {
+		t.Fatalf("first passenger's last audit entry is %s %q, want the swap to A-4", last.Action, last.Detail)
+	}
+	checkNoDoubleBooking(t, s)
+}
+
+func TestSeatChangeDropsPendingSwapRequest(t *testing.T) {
+	for _, mover := range []string{"first", "second"} {
+		s := newTestServer(t)
+		first := purchaseTicket(t, s, "first@example.com", "London", "Paris")
+		second := purchaseTicket(t, s, "second@example.com", "London", "Paris")
+		if err := seatInA(t, s, first.PurchaseId, 3); err != nil {
+			t.Fatalf("seating first in A3: %v", err)
+		}
+		if err := seatInA(t, s, second.PurchaseId, 4); err != nil {
+			t.Fatalf("seating second in A4: %v", err)
+		}
+		_, err := s.SwapSeats(passengerContext("first@example.com"), &pb.SwapSeatsRequest{PurchaseIdA: first.PurchaseId, PurchaseIdB: second.PurchaseId})
+		if err != nil {
+			t.Fatalf("SwapSeats asked by first: %v", err)
+		}
+
+		// Either passenger moving seats drops the request the first made
+		moved := map[string]string{"first": first.PurchaseId, "second": second.PurchaseId}[mover]
+		_, err = s.ModifySeat(passengerContext(mover+"@example.com"), &pb.ModifySeatRequest{PurchaseId: moved, NewSection: "A", NewSeatNumber: 7})
+		if err != nil {
+			t.Fatalf("ModifySeat by %s: %v", mover, err)
+		}
+		receipt, err := s.lookupTicket(first.PurchaseId, "")
+		if err != nil {
+			t.Fatalf("lookupTicket: %v", err)
+		}
+		if receipt.SwapRequest != nil {
+			t.Fatalf("after %s moved, first still asks to swap with %s", mover, receipt.SwapRequest.WithPurchaseId)
+		}
+
+		// So the second passenger asking only records a request of their own
+		response, err := s.SwapSeats(passengerContext("second@example.com"), &pb.SwapSeatsRequest{PurchaseIdA: second.PurchaseId, PurchaseIdB: first.PurchaseId})
+		if err != nil {
+			t.Fatalf("SwapSeats asked by second: %v", err)
+		}
+		if response.Swapped {
+			t.Fatalf("after %s moved, seats were swapped on the stale request", mover)
+		}
+		checkNoDoubleBooking(t, s)
+	}
+}
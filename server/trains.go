@@ -0,0 +1,339 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Helper function to pick the train and departure date for a purchase. An
+// empty trainID selects the first train that serves from and to, and an
+// empty date selects that train's next departure from the boarding station.
+func (s *Server) resolveJourney(trainID, date, from, to string) (*Train, time.Time, error) {
+	if from == "" || to == "" {
+		return nil, time.Time{}, status.Errorf(codes.InvalidArgument, "Invalid request: From and to stations cannot be empty")
+	}
+	for _, station := range []string{from, to} {
+		if !s.catalog.HasStation(station) {
+			return nil, time.Time{}, status.Errorf(codes.InvalidArgument, "Unknown station: %s", station)
+		}
+	}
+
+	var train *Train
+	if trainID != "" {
+		train = s.catalog.Train(trainID)
+		if train == nil {
+			return nil, time.Time{}, status.Errorf(codes.NotFound, "Unknown train: %s", trainID)
+		}
+		if !train.Serves(from, to) {
+			return nil, time.Time{}, status.Errorf(codes.InvalidArgument, "Train %s does not run from %s to %s", trainID, from, to)
+		}
+	} else {
+		for _, candidate := range s.catalog.Trains {
+			if candidate.Serves(from, to) {
+				train = candidate
+				break
+			}
+		}
+		if train == nil {
+			return nil, time.Time{}, status.Errorf(codes.NotFound, "No train runs from %s to %s", from, to)
+		}
+	}
+
+	now := s.now()
+	if date == "" {
+		next, ok := train.NextDeparture(now, from)
+		if !ok {
+			return nil, time.Time{}, status.Errorf(codes.NotFound, "No upcoming departure of train %s from %s", train.ID, from)
+		}
+		return train, next, nil
+	}
+
+	day, err := parseDate(date)
+	if err != nil {
+		return nil, time.Time{}, status.Errorf(codes.InvalidArgument, "Invalid date: %s (expected YYYY-MM-DD)", date)
+	}
+	if !train.RunsOn(day) {
+		return nil, time.Time{}, status.Errorf(codes.FailedPrecondition, "Train %s does not run on %s", train.ID, date)
+	}
+	if !train.StopTime(day, from).After(now) {
+		return nil, time.Time{}, status.Errorf(codes.FailedPrecondition, "Train %s on %s has already left %s", train.ID, date, from)
+	}
+	return train, day, nil
+}
+
+// Helper function to pick the departure shown by GetSeatMap. trainID may be
+// empty when the catalog has a single train, and an empty date selects the
+// next departure from the train's origin.
+func (s *Server) resolveDeparture(trainID, date string) (*Train, time.Time, error) {
+	var train *Train
+	switch {
+	case trainID != "":
+		train = s.catalog.Train(trainID)
+		if train == nil {
+			return nil, time.Time{}, status.Errorf(codes.NotFound, "Unknown train: %s", trainID)
+		}
+	case len(s.catalog.Trains) == 1:
+		train = s.catalog.Trains[0]
+	default:
+		return nil, time.Time{}, status.Errorf(codes.InvalidArgument, "Invalid request: Train id cannot be empty")
+	}
+
+	if date == "" {
+		next, ok := train.NextDeparture(s.now(), train.Stops[0].Station)
+		if !ok {
+			return nil, time.Time{}, status.Errorf(codes.NotFound, "No upcoming departure of train %s", train.ID)
+		}
+		return train, next, nil
+	}
+
+	day, err := parseDate(date)
+	if err != nil {
+		return nil, time.Time{}, status.Errorf(codes.InvalidArgument, "Invalid date: %s (expected YYYY-MM-DD)", date)
+	}
+	if !train.RunsOn(day) {
+		return nil, time.Time{}, status.Errorf(codes.FailedPrecondition, "Train %s does not run on %s", train.ID, date)
+	}
+	return train, day, nil
+}
+
+// Helper function to look up the train a receipt was purchased for
+func (s *Server) receiptTrain(receipt *pb.Receipt) (*Train, error) {
+	train := s.catalog.Train(receipt.TrainId)
+	if train == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Train %q of this booking is no longer in service", receipt.TrainId)
+	}
+	return train, nil
+}
+
+// seatMapView is a departure's seats as shown to a GetSeatMap or
+// WatchSeatAvailability caller: some or all sections, occupancy on a leg.
+type seatMapView struct {
+	train     *Train
+	departure Departure
+	sections  []*SectionLayout
+	leg       Leg
+}
+
+// Helper function to work out which seats a seat map request covers
+func (s *Server) resolveSeatMapView(trainID, date, sectionName, from, to string) (*seatMapView, error) {
+	train, day, err := s.resolveDeparture(trainID, date)
+	if err != nil {
+		return nil, err
+	}
+	view := &seatMapView{
+		train:     train,
+		departure: Departure{TrainID: train.ID, Date: day.Format(dateLayout)},
+		sections:  train.Sections,
+		leg:       train.FullRoute(),
+	}
+
+	// Show occupancy for the requested leg, or for any part of the route
+	if from != "" || to != "" {
+		if !train.Serves(from, to) {
+			return nil, status.Errorf(codes.InvalidArgument, "Train %s does not run from %s to %s", train.ID, from, to)
+		}
+		view.leg = train.LegBetween(from, to)
+	}
+
+	if sectionName != "" {
+		section := train.Section(sectionName)
+		if section == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid section: %s (available: %s)", sectionName, train.SectionNames())
+		}
+		view.sections = []*SectionLayout{section}
+	}
+	return view, nil
+}
+
+// Helper function to describe one seat of a view. Callers must hold s.mu.
+func (s *Server) seatInfo(view *seatMapView, section *SectionLayout, seat SeatLayout) *pb.SeatInfo {
+	return &pb.SeatInfo{
+		SeatNumber:    seat.Number,
+		Row:           seat.Row,
+		Column:        seat.Column,
+		Window:        seat.Window,
+		Aisle:         seat.Aisle,
+		Occupied:      !s.seatFree(view.train, view.departure, section.Name, seat.Number, view.leg),
+		ForwardFacing: seat.Forward,
+		NearExit:      seat.NearExit,
+	}
+}
+
+// Helper function to build the seat map of a view. Callers must hold s.mu.
+func (s *Server) seatMap(view *seatMapView) *pb.GetSeatMapResponse {
+	getSeatMapResponse := &pb.GetSeatMapResponse{
+		TrainId: view.departure.TrainID,
+		Date:    view.departure.Date,
+	}
+	for _, section := range view.sections {
+		sectionMap := &pb.SectionMap{
+			Section:   section.Name,
+			Coach:     section.Coach,
+			SeatClass: section.Class,
+			Capacity:  int32(section.Capacity),
+			Quiet:     section.Quiet,
+		}
+		for _, seat := range section.Seats() {
+			seatInfo := s.seatInfo(view, section, seat)
+			if !seatInfo.Occupied {
+				sectionMap.Available++
+			}
+			sectionMap.Seats = append(sectionMap.Seats, seatInfo)
+		}
+		getSeatMapResponse.Sections = append(getSeatMapResponse.Sections, sectionMap)
+	}
+	return getSeatMapResponse
+}
+
+func (s *Server) GetSeatMap(ctx context.Context, req *pb.GetSeatMapRequest) (*pb.GetSeatMapResponse, error) {
+	// Validate the request
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	view, err := s.resolveSeatMapView(req.TrainId, req.Date, req.Section, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.seatMap(view), nil
+}
+
+func (s *Server) ListTrains(ctx context.Context, req *pb.ListTrainsRequest) (*pb.ListTrainsResponse, error) {
+	listTrainsResponse := &pb.ListTrainsResponse{}
+	for _, train := range s.catalog.Trains {
+		trainInfo := &pb.Train{
+			Id:      train.ID,
+			Name:    train.Name,
+			Departs: train.Departs,
+			Days:    train.Days,
+		}
+		for _, stop := range train.Stops {
+			trainInfo.Stops = append(trainInfo.Stops, &pb.Stop{Station: stop.Station, Minutes: int32(stop.Minutes), Km: int32(stop.Km)})
+		}
+		for _, section := range train.Sections {
+			trainInfo.Sections = append(trainInfo.Sections, section.Name)
+		}
+		listTrainsResponse.Trains = append(listTrainsResponse.Trains, trainInfo)
+	}
+
+	return listTrainsResponse, nil
+}
+
+func (s *Server) SearchJourneys(ctx context.Context, req *pb.SearchJourneysRequest) (*pb.SearchJourneysResponse, error) {
+	// Validate the request
+	if req == nil || req.From == "" || req.To == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: From and to stations cannot be empty")
+	}
+	for _, station := range []string{req.From, req.To} {
+		if !s.catalog.HasStation(station) {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown station: %s", station)
+		}
+	}
+
+	now := s.now()
+	day := now
+	if req.Date != "" {
+		parsed, err := parseDate(req.Date)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid date: %s (expected YYYY-MM-DD)", req.Date)
+		}
+		day = parsed
+	}
+	date := day.Format(dateLayout)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	searchJourneysResponse := &pb.SearchJourneysResponse{}
+	for _, train := range s.catalog.Trains {
+		if !train.Serves(req.From, req.To) || !train.RunsOn(day) {
+			continue
+		}
+		departureTime := train.StopTime(day, req.From)
+		if !departureTime.After(now) {
+			continue
+		}
+		searchJourneysResponse.Journeys = append(searchJourneysResponse.Journeys, &pb.Journey{
+			TrainId:        train.ID,
+			TrainName:      train.Name,
+			Date:           date,
+			From:           req.From,
+			To:             req.To,
+			DepartureTime:  departureTime.Format(time.RFC3339),
+			ArrivalTime:    train.StopTime(day, req.To).Format(time.RFC3339),
+			AvailableSeats: s.availableSeats(train, Departure{TrainID: train.ID, Date: date}, train.LegBetween(req.From, req.To)),
+		})
+	}
+
+	return searchJourneysResponse, nil
+}
+
+func (s *Server) QuoteFare(ctx context.Context, req *pb.QuoteFareRequest) (*pb.QuoteFareResponse, error) {
+	// Validate the request
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request")
+	}
+
+	train, date, err := s.resolveJourney(req.TrainId, req.Date, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	seatClass, err := s.resolveSeatClass(train, req.SeatClass)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	fare, err := s.catalog.Pricing.Quote(train, date, req.From, req.To, seatClass, now)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if req.PromoCode != "" {
+		s.mu.RLock()
+		promo, err := s.usablePromo(req.PromoCode, "", now)
+		s.mu.RUnlock()
+		if err != nil {
+			return nil, err
+		}
+		fare.ApplyDiscount(promoDiscount(promo, fare.Total))
+	}
+
+	quoteFareResponse := &pb.QuoteFareResponse{
+		TrainId:   train.ID,
+		Date:      date.Format(dateLayout),
+		SeatClass: seatClass,
+		Lines:     fare.Lines,
+		Total:     fare.Total,
+		Discount:  fare.Discount,
+	}
+
+	return quoteFareResponse, nil
+}
+
+// Helper function to default and check the seat class of a purchase. A
+// class the catalog does not price is unknown, which is told apart from a
+// class this train does not carry.
+func (s *Server) resolveSeatClass(train *Train, seatClass string) (string, error) {
+	if seatClass == "" {
+		seatClass = "standard"
+	}
+	if _, ok := s.catalog.Pricing.Classes[seatClass]; !ok {
+		return "", status.Errorf(codes.InvalidArgument, "Unknown seat class %q", seatClass)
+	}
+	for _, section := range train.Sections {
+		if section.Class == seatClass {
+			return seatClass, nil
+		}
+	}
+	return "", status.Errorf(codes.InvalidArgument, "Train %s has no %s class seats", train.ID, seatClass)
+}
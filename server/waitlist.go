@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Helper function to list the passengers waiting for a seat on a departure,
+// first come first served. Callers must hold s.mu.
+func (s *Server) waitlistQueue(departure Departure) []*pb.Receipt {
+	queue := s.store.ListWaitlisted(departure)
+	joined := make(map[string]time.Time, len(queue))
+	for _, receipt := range queue {
+		joined[receipt.PurchaseId], _ = time.Parse(time.RFC3339Nano, receipt.Waitlist.JoinedAt)
+	}
+	sort.SliceStable(queue, func(i, j int) bool {
+		return joined[queue[i].PurchaseId].Before(joined[queue[j].PurchaseId])
+	})
+	return queue
+}
+
+// Helper function to list the sections a waitlisted passenger would accept
+func waitlistSections(train *Train, receipt *pb.Receipt) []*SectionLayout {
+	if receipt.Waitlist.Section != "" {
+		if section := train.Section(receipt.Waitlist.Section); section != nil {
+			return []*SectionLayout{section}
+		}
+		return nil
+	}
+	var sections []*SectionLayout
+	for _, section := range train.Sections {
+		if checkSeatClass(receipt, section) == nil {
+			sections = append(sections, section)
+		}
+	}
+	return sections
+}
+
+// Helper function to find a free seat for a waitlisted passenger.
+// Callers must hold s.mu.
+func (s *Server) waitlistSeat(train *Train, receipt *pb.Receipt) (*SectionLayout, int32, bool) {
+	for _, section := range waitlistSections(train, receipt) {
+		seatNumber, available := s.findNextAvailableSeat(train, receiptDeparture(receipt), section, receiptLeg(train, receipt))
+		if available {
+			return section, seatNumber, true
+		}
+	}
+	return nil, 0, false
+}
+
+// Helper function to report whether two waitlist entries compete for the
+// same seats
+func waitlistsOverlap(a, b *pb.WaitlistEntry) bool {
+	return a.Section == "" || b.Section == "" || a.Section == b.Section
+}
+
+// Helper function to work out how far a passenger is from the front of the
+// waitlist: 1 plus the passengers ahead of them who want the same seats.
+// Callers must hold s.mu.
+func (s *Server) waitlistPosition(receipt *pb.Receipt) int32 {
+	position := int32(1)
+	for _, other := range s.waitlistQueue(receiptDeparture(receipt)) {
+		if other.PurchaseId == receipt.PurchaseId {
+			break
+		}
+		if waitlistsOverlap(other.Waitlist, receipt.Waitlist) {
+			position++
+		}
+	}
+	return position
+}
+
+// serveWaitlist gives seats that have become free on a departure to the
+// passengers waiting for them, in the order they joined. A passenger whose
+// leg or section has no free seat is skipped, so a seat freed between Paris
+// and Brussels can go to someone further back who travels only that far.
+// Callers must hold s.mu for writing.
+func (s *Server) serveWaitlist(departure Departure) {
+	train := s.catalog.Train(departure.TrainID)
+	if train == nil {
+		return
+	}
+
+	for _, receipt := range s.waitlistQueue(departure) {
+		s.refreshStatus(receipt)
+		if !canTransition(receipt.Status, pb.TicketStatus_TICKET_STATUS_SEATED) {
+			continue
+		}
+		section, seatNumber, available := s.waitlistSeat(train, receipt)
+		if !available {
+			continue
+		}
+
+		// A seat the passenger was holding is given up for this one, so the
+		// hold can neither move them later nor keep the seat out of sale
+		s.releaseHold(receipt.PurchaseId)
+		receipt.Seat = &pb.Seat{Section: section.Name, SeatNumber: seatNumber}
+		receipt.Waitlist = nil
+		receipt.Status = pb.TicketStatus_TICKET_STATUS_SEATED
+		if err := s.dropSwapRequests(receipt); err != nil {
+			log.Printf("booking store: dropping swap requests for purchase %s: %v", receipt.PurchaseId, err)
+			return
+		}
+		if err := s.store.PutReceipt(receipt); err != nil {
+			log.Printf("booking store: seating waitlisted purchase %s: %v", receipt.PurchaseId, err)
+			return
+		}
+
+		log.Printf("waitlist: seated purchase %s in %s-%d on %s", receipt.PurchaseId, section.Name, seatNumber, departure)
+		s.emitSeat(eventWaitlistAllocated, receipt)
+	}
+}
+
+func (s *Server) JoinWaitlist(ctx context.Context, req *pb.JoinWaitlistRequest) (*pb.JoinWaitlistResponse, error) {
+	// Validate the request
+	if req == nil || req.PurchaseId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Purchase id cannot be empty")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	receipt, err := s.lookupOwnTicket(ctx, req.PurchaseId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the seat is already allocated for the user
+	if receipt.Status == pb.TicketStatus_TICKET_STATUS_SEATED {
+		return nil, status.Errorf(codes.FailedPrecondition, "Seat already allocated for purchase %s", receipt.PurchaseId)
+	}
+	if err := checkTransition(receipt, pb.TicketStatus_TICKET_STATUS_SEATED, "join the waitlist"); err != nil {
+		return nil, err
+	}
+	if receipt.Waitlist != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "Purchase %s is already on the waitlist", receipt.PurchaseId)
+	}
+
+	train, err := s.receiptTrain(receipt)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Section != "" {
+		section := train.Section(req.Section)
+		if section == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid section: %s (available: %s)", req.Section, train.SectionNames())
+		}
+		if err := checkSeatClass(receipt, section); err != nil {
+			return nil, err
+		}
+	}
+
+	receipt.Waitlist = &pb.WaitlistEntry{
+		Section:  req.Section,
+		JoinedAt: s.now().Format(time.RFC3339Nano),
+	}
+
+	// Only passengers who cannot be seated now may wait
+	if section, _, available := s.waitlistSeat(train, receipt); available {
+		return nil, status.Errorf(codes.FailedPrecondition, "Seats are still available in section %s", section.Name)
+	}
+
+	if err := s.store.PutReceipt(receipt); err != nil {
+		return nil, storeError(err)
+	}
+
+	return &pb.JoinWaitlistResponse{Position: s.waitlistPosition(receipt)}, nil
+}
+
+func (s *Server) LeaveWaitlist(ctx context.Context, req *pb.LeaveWaitlistRequest) (*pb.LeaveWaitlistResponse, error) {
+	// Validate the request
+	if req == nil || req.PurchaseId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Purchase id cannot be empty")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	receipt, err := s.lookupOwnTicket(ctx, req.PurchaseId, "")
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Waitlist == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Purchase %s is not on the waitlist", receipt.PurchaseId)
+	}
+
+	receipt.Waitlist = nil
+	if err := s.store.PutReceipt(receipt); err != nil {
+		return nil, storeError(err)
+	}
+
+	return &pb.LeaveWaitlistResponse{Res: "Left the waitlist successfully"}, nil
+}
+
+func (s *Server) GetWaitlistPosition(ctx context.Context, req *pb.GetWaitlistPositionRequest) (*pb.GetWaitlistPositionResponse, error) {
+	// Validate the request
+	if req == nil || req.PurchaseId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid request: Purchase id cannot be empty")
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	receipt, err := s.lookupOwnTicket(ctx, req.PurchaseId, "")
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Waitlist == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Purchase %s is not on the waitlist", receipt.PurchaseId)
+	}
+
+	getWaitlistPositionResponse := &pb.GetWaitlistPositionResponse{
+		Position: s.waitlistPosition(receipt),
+		Entry:    receipt.Waitlist,
+	}
+
+	return getWaitlistPositionResponse, nil
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	pb "github.com/harshithvh/go_gRPC/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Helper function to seat a passenger between two stations in the next
+// free seat of section A and return their purchase id
+func seatedInA(t *testing.T, s *Server, email, from, to string) string {
+	t.Helper()
+	purchaseID := purchaseTicket(t, s, email, from, to).PurchaseId
+	if _, err := s.AllocateSeat(adminContext(), &pb.AllocateSeatRequest{PurchaseId: purchaseID, Section: "A"}); err != nil {
+		t.Fatalf("AllocateSeat(%s): %v", purchaseID, err)
+	}
+	return purchaseID
+}
+
+// Helper function to buy a ticket and put it on the waitlist for section A
+func waitForA(t *testing.T, s *Server, email, from, to string) string {
+	t.Helper()
+	purchaseID := purchaseTicket(t, s, email, from, to).PurchaseId
+	if _, err := s.JoinWaitlist(adminContext(), &pb.JoinWaitlistRequest{PurchaseId: purchaseID, Section: "A"}); err != nil {
+		t.Fatalf("JoinWaitlist(%s): %v", purchaseID, err)
+	}
+	return purchaseID
+}
+
+// Helper function to get the seat a ticket has, as section-number
+func ticketSeat(t *testing.T, s *Server, purchaseID string) string {
+	t.Helper()
+	receipt, err := s.ShowReceipt(adminContext(), &pb.ShowReceiptRequest{PurchaseId: purchaseID})
+	if err != nil {
+		t.Fatalf("ShowReceipt(%s): %v", purchaseID, err)
+	}
+	return seatLabel(receipt.GetUserInfo().GetSeat())
+}
+
+func TestWaitlistedSeatGivesUpHold(t *testing.T) {
+	s := newTestServer(t)
+	ctx := adminContext()
+	var seated []string
+	for i := 0; i < 10; i++ {
+		seated = append(seated, seatedInA(t, s, fmt.Sprintf("seated%d@example.com", i), "London", "Paris"))
+	}
+
+	// The passenger holds a seat in B while waiting for one in A
+	waiting := waitForA(t, s, "waiting@example.com", "London", "Paris")
+	held, err := s.HoldSeat(ctx, &pb.HoldSeatRequest{PurchaseId: waiting, Section: "B", SeatNumber: 1})
+	if err != nil {
+		t.Fatalf("HoldSeat: %v", err)
+	}
+
+	if _, err := s.CancelTicket(ctx, &pb.CancelTicketRequest{PurchaseId: seated[0]}); err != nil {
+		t.Fatalf("CancelTicket: %v", err)
+	}
+	if seat := ticketSeat(t, s, waiting); seat != "A-1" {
+		t.Fatalf("waiting passenger is in seat %s, want A-1", seat)
+	}
+
+	// The hold went with the wait, so it cannot move them and B-1 is free
+	_, err = s.ConfirmHold(ctx, &pb.ConfirmHoldRequest{HoldId: held.Hold.HoldId})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("ConfirmHold after being seated from the waitlist: got %v, want NotFound", err)
+	}
+	other := purchaseTicket(t, s, "other@example.com", "London", "Paris")
+	if _, err := s.HoldSeat(ctx, &pb.HoldSeatRequest{PurchaseId: other.PurchaseId, Section: "B", SeatNumber: 1}); err != nil {
+		t.Fatalf("HoldSeat of the seat given up: %v", err)
+	}
+	checkNoDoubleBooking(t, s)
+}
+
+func TestWaitlistIsServedInJoinOrder(t *testing.T) {
+	s := newTestServer(t)
+	clock := &fakeClock{now: time.Now()}
+	s.now = clock.Now
+	ctx := adminContext()
+	var seated []string
+	for i := 0; i < 10; i++ {
+		seated = append(seated, seatedInA(t, s, fmt.Sprintf("seated%d@example.com", i), "London", "Paris"))
+	}
+
+	var waiting []string
+	for i := 0; i < 3; i++ {
+		clock.Advance(time.Second)
+		waiting = append(waiting, waitForA(t, s, fmt.Sprintf("waiting%d@example.com", i), "London", "Paris"))
+	}
+
+	// Each freed seat goes to the passenger who has waited longest, and
+	// everyone behind them moves up
+	for i, freed := range seated[:3] {
+		if _, err := s.CancelTicket(ctx, &pb.CancelTicketRequest{PurchaseId: freed}); err != nil {
+			t.Fatalf("CancelTicket(%s): %v", freed, err)
+		}
+		if seat := ticketSeat(t, s, waiting[i]); seat != fmt.Sprintf("A-%d", i+1) {
+			t.Fatalf("waiting passenger %d is in seat %s, want A-%d", i, seat, i+1)
+		}
+		for j, purchaseID := range waiting[i+1:] {
+			response, err := s.GetWaitlistPosition(ctx, &pb.GetWaitlistPositionRequest{PurchaseId: purchaseID})
+			if err != nil {
+				t.Fatalf("GetWaitlistPosition(%s): %v", purchaseID, err)
+			}
+			if response.Position != int32(j+1) {
+				t.Fatalf("after %d seats freed, waiting passenger %d is at %d, want %d", i+1, i+1+j, response.Position, j+1)
+			}
+		}
+	}
+	checkNoDoubleBooking(t, s)
+}
+
+func TestWaitlistSkipsPassengersWhoCannotBeSeated(t *testing.T) {
+	s := newTestServer(t)
+	clock := &fakeClock{now: time.Now()}
+	s.now = clock.Now
+	ctx := adminContext()
+
+	// A-10 is the only seat not taken all the way, and it is taken on
+	// both legs
+	for i := 0; i < 9; i++ {
+		seatedInA(t, s, fmt.Sprintf("seated%d@example.com", i), "London", "Brussels")
+	}
+	seatedInA(t, s, "first-leg@example.com", "London", "Paris")
+	secondLeg := seatedInA(t, s, "second-leg@example.com", "Paris", "Brussels")
+
+	clock.Advance(time.Second)
+	whole := waitForA(t, s, "whole@example.com", "London", "Brussels")
+	clock.Advance(time.Second)
+	later := waitForA(t, s, "later@example.com", "Paris", "Brussels")
+
+	// Freeing A-10 from Paris only seats the passenger further back
+	if _, err := s.CancelTicket(ctx, &pb.CancelTicketRequest{PurchaseId: secondLeg}); err != nil {
+		t.Fatalf("CancelTicket: %v", err)
+	}
+	if seat := ticketSeat(t, s, later); seat != "A-10" {
+		t.Fatalf("Paris - Brussels passenger is in seat %s, want A-10", seat)
+	}
+	response, err := s.GetWaitlistPosition(ctx, &pb.GetWaitlistPositionRequest{PurchaseId: whole})
+	if err != nil {
+		t.Fatalf("London - Brussels passenger left the waitlist: %v", err)
+	}
+	if response.Position != 1 {
+		t.Fatalf("London - Brussels passenger is at %d, want still first", response.Position)
+	}
+	checkNoDoubleBooking(t, s)
+}
+
+func TestWaitlistNeedsAFullSection(t *testing.T) {
+	s := newTestServer(t)
+	purchaseID := purchaseTicket(t, s, "early@example.com", "London", "Paris").PurchaseId
+
+	_, err := s.JoinWaitlist(adminContext(), &pb.JoinWaitlistRequest{PurchaseId: purchaseID, Section: "A"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("JoinWaitlist with seats free: got %v, want FailedPrecondition", err)
+	}
+}